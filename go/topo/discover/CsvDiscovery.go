@@ -0,0 +1,45 @@
+package discover
+
+import (
+	"encoding/csv"
+	"errors"
+	"os"
+	"strings"
+)
+
+// CsvDiscovery is a discovery of the devices of a csv file, e.g. legacy devices that are in no inventory.
+// The first row names the columns, the definition paths are column names.
+type CsvDiscovery struct {
+	*GenericDiscovery
+}
+
+// StaticElements reads the rows of the csv file, each row is a map of the row values by column name
+func (this *CsvDiscovery) StaticElements() ([]interface{}, error) {
+	file, err := os.Open(this.definition.CsvFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.New(this.definition.CsvFile + ": " + err.Error())
+	}
+	if len(rows) == 0 {
+		return nil, errors.New(this.definition.CsvFile + ": no header row")
+	}
+	header := rows[0]
+	elems := make([]interface{}, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		elem := make(map[string]string, len(header))
+		for i, column := range header {
+			if i < len(row) {
+				elem[strings.TrimSpace(column)] = strings.TrimSpace(row[i])
+			}
+		}
+		elems = append(elems, elem)
+	}
+	return elems, nil
+}
@@ -0,0 +1,197 @@
+package discover
+
+import (
+	"errors"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/web"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	DiscoveryServiceName = "TopoDisc"
+	DiscoveryServiceArea = byte(0)
+)
+
+// DiscoveryService activates a topology service for each discovery definition POSTed to it,
+// deactivates it when the definition is DELETEd, and lists the active definitions
+type DiscoveryService struct {
+	mtx         sync.Mutex
+	vnic        ifs.IVNic
+	definitions map[string]*l8topo.L8TopologyDiscovery
+}
+
+func ActivateDiscoveryService(nic ifs.IVNic) {
+	sla := ifs.NewServiceLevelAgreement(&DiscoveryService{}, DiscoveryServiceName, DiscoveryServiceArea, true, nil)
+	nic.Resources().Services().Activate(sla, nic)
+}
+
+// LoadDiscoveries activates the discovery definitions of a json file of an L8TopologyDiscoveryList,
+// through the discovery service if it is active so they are listed by it.
+// A definition of a csv file is accepted only from this local configuration, it is not accepted in a POST.
+func LoadDiscoveries(path string, nic ifs.IVNic) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	list := &l8topo.L8TopologyDiscoveryList{}
+	if err = protojson.Unmarshal(data, list); err != nil {
+		return errors.New(path + ": " + err.Error())
+	}
+	handler, ok := nic.Resources().Services().ServiceHandler(DiscoveryServiceName, DiscoveryServiceArea)
+	service, _ := handler.(*DiscoveryService)
+	for _, definition := range list.List {
+		if ok && service != nil {
+			err = service.activate(definition)
+		} else {
+			err = ActivateDiscovery(definition, nic)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func discoveryKeyOf(definition *l8topo.L8TopologyDiscovery) string {
+	return definition.ServiceName + "/" + strconv.Itoa(int(definition.ServiceArea))
+}
+
+func (this *DiscoveryService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic) error {
+	this.vnic = vnic
+	this.definitions = make(map[string]*l8topo.L8TopologyDiscovery)
+	vnic.Resources().Registry().Register(&l8topo.L8TopologyDiscovery{})
+	vnic.Resources().Registry().Register(&l8topo.L8TopologyDiscoveryList{})
+	return nil
+}
+
+func (this *DiscoveryService) DeActivate() error {
+	return nil
+}
+
+func (this *DiscoveryService) Post(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	for _, elem := range elements.Elements() {
+		definition, ok := elem.(*l8topo.L8TopologyDiscovery)
+		if !ok {
+			return object.NewError("unexpected element, expected a topology discovery definition")
+		}
+		if hasCsvFile(definition) {
+			return object.NewError("discovery definition " + definition.Name + " of a csv file can only be loaded from the local configuration")
+		}
+		err := this.activate(definition)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+	}
+	return nil
+}
+
+// hasCsvFile returns true if the definition or one of its sources reads a file of the server
+func hasCsvFile(definition *l8topo.L8TopologyDiscovery) bool {
+	if definition.CsvFile != "" {
+		return true
+	}
+	for _, source := range definition.Sources {
+		if source != nil && source.CsvFile != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (this *DiscoveryService) activate(definition *l8topo.L8TopologyDiscovery) error {
+	if err := validateDiscovery(definition); err != nil {
+		return err
+	}
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	key := discoveryKeyOf(definition)
+	if _, ok := this.definitions[key]; ok {
+		return errors.New("topology service " + key + " is already active")
+	}
+	if _, ok := this.vnic.Resources().Services().ServiceHandler(definition.ServiceName, byte(definition.ServiceArea)); ok {
+		return errors.New("service " + key + " already exists")
+	}
+	if err := ActivateDiscovery(definition, this.vnic); err != nil {
+		return err
+	}
+	this.definitions[key] = definition
+	return nil
+}
+
+func (this *DiscoveryService) Put(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("topology discovery definitions cannot be changed, post a new definition")
+}
+
+func (this *DiscoveryService) Patch(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	return object.NewError("topology discovery definitions cannot be changed, post a new definition")
+}
+
+// Delete deactivates the topology services of the definitions, which removes them from the topology list
+func (this *DiscoveryService) Delete(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	for _, elem := range elements.Elements() {
+		definition, ok := elem.(*l8topo.L8TopologyDiscovery)
+		if !ok {
+			return object.NewError("unexpected element, expected a topology discovery definition")
+		}
+		err := this.deactivate(definition)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+	}
+	return nil
+}
+
+func (this *DiscoveryService) deactivate(definition *l8topo.L8TopologyDiscovery) error {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	key := discoveryKeyOf(definition)
+	if _, ok := this.definitions[key]; !ok {
+		return errors.New("topology service " + key + " is not active")
+	}
+	err := this.vnic.Resources().Services().DeActivate(definition.ServiceName, byte(definition.ServiceArea), this.vnic.Resources(), this.vnic)
+	if err != nil {
+		return err
+	}
+	delete(this.definitions, key)
+	return nil
+}
+
+// Get returns the definitions of the topologies activated by the service
+func (this *DiscoveryService) Get(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	keys := make([]string, 0, len(this.definitions))
+	for key := range this.definitions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	list := &l8topo.L8TopologyDiscoveryList{}
+	for _, key := range keys {
+		list.List = append(list.List, this.definitions[key])
+	}
+	return object.New(nil, list)
+}
+
+func (this *DiscoveryService) Failed(elements ifs.IElements, vnic ifs.IVNic, msg *ifs.Message) ifs.IElements {
+	return nil
+}
+
+func (this *DiscoveryService) TransactionConfig() ifs.ITransactionConfig {
+	return nil
+}
+
+func (this *DiscoveryService) WebService() ifs.IWebService {
+	return web.New(DiscoveryServiceName, DiscoveryServiceArea,
+		&l8topo.L8TopologyDiscovery{}, nil,
+		nil, nil,
+		nil, nil,
+		&l8topo.L8TopologyDiscovery{}, nil,
+		&l8topo.L8TopologyDiscovery{}, &l8topo.L8TopologyDiscoveryList{})
+}
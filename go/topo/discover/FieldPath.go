@@ -0,0 +1,105 @@
+package discover
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// valueOf returns the value of a dot separated field path of an element, e.g. "Equipmentinfo.Location".
+// Field names and map keys are case insensitive, a number selects a slice element and any other name selects a map key,
+// e.g. "Interfaces.0.Speed".
+func valueOf(elem interface{}, path string) (reflect.Value, bool) {
+	value := reflect.ValueOf(elem)
+	if path == "" || !value.IsValid() {
+		return reflect.Value{}, false
+	}
+	for _, name := range strings.Split(path, ".") {
+		for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+			if value.IsNil() {
+				return reflect.Value{}, false
+			}
+			value = value.Elem()
+		}
+		switch value.Kind() {
+		case reflect.Struct:
+			value = value.FieldByNameFunc(func(field string) bool {
+				return strings.EqualFold(field, name)
+			})
+		case reflect.Slice, reflect.Array:
+			index, err := strconv.Atoi(name)
+			if err != nil || index < 0 || index >= value.Len() {
+				return reflect.Value{}, false
+			}
+			value = value.Index(index)
+		case reflect.Map:
+			if value.Type().Key().Kind() != reflect.String {
+				return reflect.Value{}, false
+			}
+			value = mapIndexOf(value, name)
+		default:
+			return reflect.Value{}, false
+		}
+		if !value.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}, false
+		}
+		value = value.Elem()
+	}
+	return value, true
+}
+
+// mapIndexOf returns the value of a map key, or of the key that is equal to it ignoring case
+func mapIndexOf(value reflect.Value, name string) reflect.Value {
+	result := value.MapIndex(reflect.ValueOf(name).Convert(value.Type().Key()))
+	if result.IsValid() {
+		return result
+	}
+	iter := value.MapRange()
+	for iter.Next() {
+		if strings.EqualFold(iter.Key().String(), name) {
+			return iter.Value()
+		}
+	}
+	return reflect.Value{}
+}
+
+// stringOf returns the field path value as a string, an enum as its name
+func stringOf(elem interface{}, path string) string {
+	value, ok := valueOf(elem, path)
+	if !ok {
+		return ""
+	}
+	if value.Kind() == reflect.String {
+		return value.String()
+	}
+	if value.CanInterface() {
+		return fmt.Sprint(value.Interface())
+	}
+	return ""
+}
+
+// floatOf returns the field path value as a float, zero if it is not a number
+func floatOf(elem interface{}, path string) float64 {
+	value, ok := valueOf(elem, path)
+	if !ok {
+		return 0
+	}
+	switch value.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return value.Float()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint())
+	case reflect.String:
+		number, _ := strconv.ParseFloat(strings.TrimSpace(value.String()), 64)
+		return number
+	}
+	return 0
+}
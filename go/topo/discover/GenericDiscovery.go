@@ -0,0 +1,292 @@
+package discover
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/saichler/l8topology/go/topo/topo_service"
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+// GenericDiscovery discovers a topology from a declarative definition, instead of a Go type like Layer1.
+// The definition names the inventory service and query, and the field paths of the inventory elements.
+// The inventory types are expected to be registered by their inventory service.
+type GenericDiscovery struct {
+	locator
+	definition *l8topo.L8TopologyDiscovery
+}
+
+// NewGenericDiscovery validates the definition and returns its discovery,
+// a discovery of a csv file if the definition has one
+func NewGenericDiscovery(definition *l8topo.L8TopologyDiscovery, nic ifs.IVNic) (topo_service.ITopoDiscovery, error) {
+	if err := validateDiscovery(definition); err != nil {
+		return nil, err
+	}
+	return newDiscoveryOf(definition, nic), nil
+}
+
+func newDiscoveryOf(definition *l8topo.L8TopologyDiscovery, nic ifs.IVNic) topo_service.ITopoDiscovery {
+	discovery := &GenericDiscovery{locator: newLocator(definition.Name, nic), definition: definition}
+	if definition.CsvFile != "" {
+		return &CsvDiscovery{GenericDiscovery: discovery}
+	}
+	return discovery
+}
+
+// validateDiscovery validates the topology service of a definition, and the elements of the definition and of its sources
+func validateDiscovery(definition *l8topo.L8TopologyDiscovery) error {
+	if definition == nil {
+		return errors.New("nil discovery definition")
+	}
+	if err := validateRequired(definition, []struct{ name, value string }{
+		{"name", definition.Name},
+		{"service name", definition.ServiceName},
+	}); err != nil {
+		return err
+	}
+	if definition.ServiceArea < 0 || definition.ServiceArea > 255 {
+		return errors.New("discovery definition " + definition.Name + " has a service area out of range")
+	}
+	if err := validateElements(definition); err != nil {
+		return err
+	}
+	for _, source := range definition.Sources {
+		if source == nil || len(source.Sources) > 0 {
+			return errors.New("discovery definition " + definition.Name + " has an invalid source")
+		}
+		if err := validateElements(source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateElements validates the inventory and the field paths of the elements of a definition or of a source
+func validateElements(definition *l8topo.L8TopologyDiscovery) error {
+	required := []struct{ name, value string }{
+		{"id path", definition.IdPath},
+		{"location path", definition.LocationPath},
+	}
+	// A csv file replaces the inventory service
+	if definition.CsvFile == "" {
+		required = append(required, []struct{ name, value string }{
+			{"inventory service name", definition.InventoryServiceName},
+			{"query", definition.Query},
+			{"model type name", definition.ModelTypeName},
+		}...)
+	}
+	if err := validateRequired(definition, required); err != nil {
+		return err
+	}
+	if definition.InventoryServiceArea < 0 || definition.InventoryServiceArea > 255 {
+		return errors.New("discovery definition " + definition.Name + " has an inventory service area out of range")
+	}
+	return nil
+}
+
+func validateRequired(definition *l8topo.L8TopologyDiscovery, required []struct{ name, value string }) error {
+	for _, field := range required {
+		if field.value == "" {
+			return errors.New("discovery definition " + definition.Name + " has no " + field.name)
+		}
+	}
+	return nil
+}
+
+// ActivateDiscovery activates the topology service of a discovery definition, the service adds itself to the topology list.
+// The definition sources are more discoveries of the topology nodes and links, e.g. of another inventory service.
+func ActivateDiscovery(definition *l8topo.L8TopologyDiscovery, nic ifs.IVNic) error {
+	if err := validateDiscovery(definition); err != nil {
+		return err
+	}
+	args := []interface{}{newDiscoveryOf(definition, nic)}
+	for _, source := range definition.Sources {
+		args = append(args, newDiscoveryOf(source, nic))
+	}
+	if len(definition.IdentityRules) > 0 {
+		args = append(args, topo_service.IdentityRules(definition.IdentityRules))
+	}
+	serviceArea := byte(definition.ServiceArea)
+	sla := ifs.NewServiceLevelAgreement(&topo_service.TopoService{}, definition.ServiceName, serviceArea, true, nil)
+	sla.SetArgs(args...)
+	_, err := nic.Resources().Services().Activate(sla, nic)
+	return err
+}
+
+func (this *GenericDiscovery) ServiceName() string {
+	return this.definition.InventoryServiceName
+}
+
+func (this *GenericDiscovery) ServiceArea() byte {
+	return byte(this.definition.InventoryServiceArea)
+}
+
+func (this *GenericDiscovery) Query() string {
+	return this.definition.Query
+}
+
+func (this *GenericDiscovery) ModelTypeName() string {
+	return this.definition.ModelTypeName
+}
+
+func (this *GenericDiscovery) Name() string {
+	return this.definition.Name
+}
+
+func (this *GenericDiscovery) Description() string {
+	return this.definition.Description
+}
+
+func (this *GenericDiscovery) Projection() string {
+	return this.definition.Projection
+}
+
+func (this *GenericDiscovery) IdOf(elem interface{}) string {
+	return stringOf(elem, this.definition.IdPath)
+}
+
+func (this *GenericDiscovery) LocationOf(elem interface{}) string {
+	return stringOf(elem, this.definition.LocationPath)
+}
+
+func (this *GenericDiscovery) ConvertToTopologyNode(elem interface{}) (*l8topo.L8TopologyNode, *l8topo.L8TopologyLocation) {
+	node := &l8topo.L8TopologyNode{}
+	node.NodeId = this.IdOf(elem)
+	node.Name = stringOf(elem, this.definition.NamePath)
+	if node.Name == "" {
+		node.Name = node.NodeId
+	}
+	node.Location = this.LocationOf(elem)
+	node.Type = this.NodeType(elem)
+	node.Status = this.nodeStatus(elem)
+	node.Attributes = this.attributesOf(elem)
+	latitude := float32(floatOf(elem, this.definition.LatitudePath))
+	longitude := float32(floatOf(elem, this.definition.LongitudePath))
+	location := this.createLocation(topo_service.GeoLocationOf(node.Location), latitude, longitude)
+	return node, location
+}
+
+// attributesOf returns the node attributes of the definition attribute paths, e.g. the serialNumber
+// and ipAddress attributes that identify the same node in several sources
+func (this *GenericDiscovery) attributesOf(elem interface{}) map[string]string {
+	if len(this.definition.AttributePaths) == 0 {
+		return nil
+	}
+	attributes := make(map[string]string, len(this.definition.AttributePaths))
+	for name, path := range this.definition.AttributePaths {
+		addAttribute(attributes, name, stringOf(elem, path))
+	}
+	return attributes
+}
+
+// NodeType maps the type value to a node type by the definition node types,
+// or else by the node type name it contains, e.g. "DEVICE_TYPE_ROUTER" is a ROUTER
+func (this *GenericDiscovery) NodeType(elem interface{}) l8topo.L8TopologyNodeType {
+	value := stringOf(elem, this.definition.TypePath)
+	if value == "" {
+		return l8topo.L8TopologyNodeType_Generic
+	}
+	if nodeType, ok := this.definition.NodeTypes[value]; ok {
+		return nodeType
+	}
+	return enumOf(value, l8topo.L8TopologyNodeType_value, l8topo.L8TopologyNodeType_Generic)
+}
+
+func (this *GenericDiscovery) nodeStatus(elem interface{}) l8topo.L8TopologyNodeStatus {
+	value := stringOf(elem, this.definition.StatusPath)
+	if value == "" {
+		return l8topo.L8TopologyNodeStatus_InvalidNodeStatus
+	}
+	if status, ok := this.definition.NodeStatuses[value]; ok {
+		return status
+	}
+	switch strings.ToLower(value) {
+	case "up", "online", "active":
+		return l8topo.L8TopologyNodeStatus_NodeUp
+	case "down", "offline", "inactive":
+		return l8topo.L8TopologyNodeStatus_NodeDown
+	}
+	return enumOf(value, l8topo.L8TopologyNodeStatus_value, l8topo.L8TopologyNodeStatus_InvalidNodeStatus)
+}
+
+// enumOf returns the enum whose name is the longest one contained in the value, ignoring case,
+// of names of the same length the enum with the lowest number wins
+func enumOf[T ~int32](value string, names map[string]int32, defaultValue T) T {
+	upper := strings.ToUpper(value)
+	result, length := defaultValue, 0
+	for name, number := range names {
+		if !strings.Contains(upper, strings.ToUpper(name)) {
+			continue
+		}
+		if len(name) > length || (len(name) == length && T(number) < result) {
+			result, length = T(number), len(name)
+		}
+	}
+	return result
+}
+
+// IsConnected matches two elements when the aside path value of one is the zside path value of the other,
+// e.g. a port neighbor id and the neighbor port id, or the same circuit id on both sides
+func (this *GenericDiscovery) IsConnected(aside, zside interface{}) (bool, l8topo.L8TopologyLinkDirection) {
+	rule := this.definition.LinkRule
+	if rule == nil || rule.AsidePath == "" {
+		return false, l8topo.L8TopologyLinkDirection_InvalidDirection
+	}
+	zsidePath := rule.ZsidePath
+	if zsidePath == "" {
+		zsidePath = rule.AsidePath
+	}
+	if matchValues(stringOf(aside, rule.AsidePath), stringOf(zside, zsidePath)) ||
+		matchValues(stringOf(zside, rule.AsidePath), stringOf(aside, zsidePath)) {
+		return true, l8topo.L8TopologyLinkDirection_Bidirectional
+	}
+	return false, l8topo.L8TopologyLinkDirection_InvalidDirection
+}
+
+func matchValues(a, z string) bool {
+	return a != "" && a == z
+}
+
+func (this *GenericDiscovery) LinkStatus(aside, zside interface{}) l8topo.L8TopologyLinkStatus {
+	rule := this.definition.LinkRule
+	if rule == nil || rule.StatusPath == "" {
+		return l8topo.L8TopologyLinkStatus_InvalidStatus
+	}
+	upValue := rule.UpValue
+	if upValue == "" {
+		upValue = "up"
+	}
+	if strings.EqualFold(stringOf(aside, rule.StatusPath), upValue) && strings.EqualFold(stringOf(zside, rule.StatusPath), upValue) {
+		return l8topo.L8TopologyLinkStatus_Up
+	}
+	return l8topo.L8TopologyLinkStatus_Down
+}
+
+func (this *GenericDiscovery) LinkCapacity(aside, zside interface{}) uint64 {
+	// The link runs at the speed of its slower side
+	return min(this.speedOf(aside), this.speedOf(zside))
+}
+
+func (this *GenericDiscovery) speedOf(elem interface{}) uint64 {
+	if this.definition.LinkRule == nil {
+		return 0
+	}
+	return uint64(floatOf(elem, this.definition.LinkRule.SpeedPath))
+}
+
+func (this *GenericDiscovery) LagOf(elem interface{}) string {
+	if this.definition.LinkRule == nil {
+		return ""
+	}
+	return stringOf(elem, this.definition.LinkRule.LagPath)
+}
+
+func (this *GenericDiscovery) LinkEndpoint(elem interface{}) *l8topo.L8TopologyLinkEndpoint {
+	endpoint := &l8topo.L8TopologyLinkEndpoint{}
+	endpoint.Speed = this.speedOf(elem)
+	if this.definition.LinkRule != nil {
+		endpoint.PortName = stringOf(elem, this.definition.LinkRule.PortNamePath)
+	}
+	return endpoint
+}
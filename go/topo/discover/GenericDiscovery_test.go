@@ -0,0 +1,144 @@
+package discover
+
+import (
+	"testing"
+
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8topology/go/topo/topo_service"
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+type testPort struct {
+	Id       string
+	Neighbor string
+	Status   string
+	Speed    uint64
+}
+
+type testDevice struct {
+	Id    string
+	Info  *struct{ Location, DeviceType string }
+	Ports []*testPort
+	Tags  map[string]string
+}
+
+func TestGenericDiscovery(t *testing.T) {
+	definition := &l8topo.L8TopologyDiscovery{
+		Name:                 "Test",
+		ServiceName:          "TestTopo",
+		InventoryServiceName: "TestInv",
+		Query:                "select * from testdevice",
+		ModelTypeName:        "testDevice",
+		IdPath:               "id",
+		LocationPath:         "info.location",
+		TypePath:             "info.devicetype",
+		LinkRule:             &l8topo.L8TopologyLinkRule{AsidePath: "neighbor", ZsidePath: "id", StatusPath: "status", SpeedPath: "speed"},
+	}
+	discovery := &GenericDiscovery{definition: definition}
+
+	device := &testDevice{Id: "r1", Info: &struct{ Location, DeviceType string }{"London, United Kingdom", "DEVICE_TYPE_ROUTER"},
+		Ports: []*testPort{{Id: "p1"}}, Tags: map[string]string{"role": "core"}}
+	if discovery.IdOf(device) != "r1" || discovery.LocationOf(device) != "London, United Kingdom" {
+		t.Fail()
+		return
+	}
+	if stringOf(device, "Ports.0.Id") != "p1" || stringOf(device, "Tags.role") != "core" || stringOf(device, "Ports.1.Id") != "" {
+		t.Fail()
+		return
+	}
+	if discovery.NodeType(device) != l8topo.L8TopologyNodeType_ROUTER {
+		t.Fail()
+		return
+	}
+
+	aside := &testPort{Id: "a", Neighbor: "z", Status: "UP", Speed: 10}
+	zside := &testPort{Id: "z", Status: "up", Speed: 1}
+	if ok, _ := discovery.IsConnected(zside, aside); !ok {
+		t.Fail()
+		return
+	}
+	if ok, _ := discovery.IsConnected(zside, &testPort{Id: "x"}); ok {
+		t.Fail()
+		return
+	}
+	if discovery.LinkStatus(aside, zside) != l8topo.L8TopologyLinkStatus_Up || discovery.LinkCapacity(aside, zside) != 1 {
+		t.Fail()
+		return
+	}
+	if validateDiscovery(&l8topo.L8TopologyDiscovery{Name: "Test"}) == nil {
+		t.Fail()
+	}
+}
+
+func TestEnumOf(t *testing.T) {
+	tests := []struct {
+		value    string
+		nodeType l8topo.L8TopologyNodeType
+	}{
+		{value: "Core Router", nodeType: l8topo.L8TopologyNodeType_ROUTER},
+		{value: "load_balancer", nodeType: l8topo.L8TopologyNodeType_LOAD_BALANCER},
+		// The longest name wins, then the lowest number of names of the same length
+		{value: "ROUTER/SWITCH", nodeType: l8topo.L8TopologyNodeType_SWITCH},
+		{value: "SWITCH/ROUTER", nodeType: l8topo.L8TopologyNodeType_SWITCH},
+		{value: "unknown", nodeType: l8topo.L8TopologyNodeType_Generic},
+	}
+	for _, test := range tests {
+		for i := 0; i < 10; i++ {
+			if nodeType := enumOf(test.value, l8topo.L8TopologyNodeType_value, l8topo.L8TopologyNodeType_Generic); nodeType != test.nodeType {
+				t.Fatal(test.value, "expected", test.nodeType, "got", nodeType)
+			}
+		}
+	}
+}
+
+func TestValidateDiscoverySources(t *testing.T) {
+	source := &l8topo.L8TopologyDiscovery{Name: "Legacy", IdPath: "id", LocationPath: "site", CsvFile: "legacy.csv"}
+	definition := &l8topo.L8TopologyDiscovery{Name: "Test", ServiceName: "TestTopo", InventoryServiceName: "TestInv",
+		Query: "select * from testdevice", ModelTypeName: "testPort", IdPath: "id", LocationPath: "info.location",
+		Sources: []*l8topo.L8TopologyDiscovery{source}}
+	if err := validateDiscovery(definition); err != nil {
+		t.Fatal(err)
+	}
+	if source.ServiceName != "" {
+		t.Fatal("expected the validation not to change the source")
+	}
+	source.IdPath = ""
+	if validateDiscovery(definition) == nil {
+		t.Fatal("expected a source without an id path to be invalid")
+	}
+}
+
+func TestPostCsvDiscovery(t *testing.T) {
+	definition := &l8topo.L8TopologyDiscovery{Name: "Test", ServiceName: "TestTopo", IdPath: "id", LocationPath: "site",
+		CsvFile: "/etc/passwd"}
+	resp := (&DiscoveryService{}).Post(object.New(nil, definition), nil)
+	if resp == nil || resp.Error() == nil {
+		t.Fatal("expected a posted csv definition to be rejected")
+	}
+	definition.CsvFile = ""
+	definition.Sources = []*l8topo.L8TopologyDiscovery{{IdPath: "id", LocationPath: "site", CsvFile: "../legacy.csv"}}
+	if !hasCsvFile(definition) {
+		t.Fatal("expected a csv source to be found")
+	}
+}
+
+// The optional discovery interfaces are asserted at runtime, these fail the build when a discovery stops implementing one
+var (
+	_ topo_service.ILinkDetails       = &Layer1{}
+	_ topo_service.ILagDiscovery      = &Layer1{}
+	_ topo_service.ILinkAttributes    = &Layer1{}
+	_ topo_service.IEndpointDiscovery = &Layer1{}
+	_ topo_service.IDescribed         = &Layer1{}
+	_ topo_service.IProjected         = &Layer1{}
+	_ topo_service.ILinkDetails       = &GenericDiscovery{}
+	_ topo_service.ILagDiscovery      = &GenericDiscovery{}
+	_ topo_service.IEndpointDiscovery = &GenericDiscovery{}
+	_ topo_service.IDescribed         = &GenericDiscovery{}
+	_ topo_service.IProjected         = &GenericDiscovery{}
+	_ topo_service.IStaticDiscovery   = &CsvDiscovery{}
+	_ topo_service.ILinkDetails       = &RoutingDiscovery{}
+	_ topo_service.ILinkAttributes    = &RoutingDiscovery{}
+	_ topo_service.IEndpointDiscovery = &RoutingDiscovery{}
+	_ topo_service.IDescribed         = &RoutingDiscovery{}
+	_ topo_service.IProjected         = &RoutingDiscovery{}
+)
@@ -1,9 +1,9 @@
 package discover
 
 import (
-	"fmt"
+	"regexp"
+	"strings"
 
-	"github.com/saichler/l8topology/go/topo/topo_list"
 	"github.com/saichler/l8topology/go/topo/topo_service"
 	"github.com/saichler/l8topology/go/types/l8topo"
 	"github.com/saichler/l8types/go/ifs"
@@ -12,6 +12,7 @@ import (
 )
 
 type Layer1 struct {
+	locator
 }
 
 const (
@@ -21,9 +22,9 @@ const (
 )
 
 func ActivateLayer1(nic ifs.IVNic) {
-	topo_list.AddTopology(Layer1Name, Layer1ServiceName, Layer1ServiceArea, nic)
 	sla := ifs.NewServiceLevelAgreement(&topo_service.TopoService{}, Layer1ServiceName, Layer1ServiceArea, true, nil)
-	sla.SetArgs(&Layer1{})
+	layer1 := &Layer1{locator: newLocator("Layer1", nic)}
+	sla.SetArgs(layer1)
 	nic.Resources().Registry().Register(&types.NetworkDeviceList{})
 	nic.Resources().Introspector().Decorators().AddPrimaryKeyDecorator(&types.NetworkDevice{}, "Id")
 	nic.Resources().Services().Activate(sla, nic)
@@ -62,10 +63,24 @@ func (this *Layer1) ConvertToTopologyNode(elem interface{}) (*l8topo.L8TopologyN
 	node.NodeId = device.Id
 	node.Name = device.Equipmentinfo.SysName
 	node.Type = this.NodeType(device)
-	location := createLocation(node.Location, float32(device.Equipmentinfo.Latitude), float32(device.Equipmentinfo.Longitude))
+	node.Status = nodeStatus(device.Equipmentinfo.DeviceStatus)
+	node.Attributes = deviceAttributes(device.Equipmentinfo)
+	location := this.createLocation(topo_service.GeoLocationOf(node.Location), float32(device.Equipmentinfo.Latitude), float32(device.Equipmentinfo.Longitude))
 	return node, location
 }
 
+func (this *Layer1) Name() string {
+	return Layer1Name
+}
+
+func (this *Layer1) Description() string {
+	return "Physical links between the ports of the inventory network devices"
+}
+
+func (this *Layer1) Projection() string {
+	return topo_service.RobinsonProjection
+}
+
 func (this *Layer1) NodeType(elem interface{}) l8topo.L8TopologyNodeType {
 	device := elem.(*types.NetworkDevice)
 	switch device.Equipmentinfo.DeviceType {
@@ -89,114 +104,37 @@ func (this *Layer1) NodeType(elem interface{}) l8topo.L8TopologyNodeType {
 	return l8topo.L8TopologyNodeType_Generic
 }
 
-func createLocation(nodeLocation string, latitude, longitude float32) *l8topo.L8TopologyLocation {
-	location := &l8topo.L8TopologyLocation{}
-	location.Location = nodeLocation
-	location.Latitude = latitude
-	location.Longitude = longitude
-
-	if location.Latitude == 0 || location.Longitude == 0 {
-		log, lat, ok := GetCityCoordinates(nodeLocation)
-		if !ok {
-			fmt.Println("Error getting log/lat for ", nodeLocation)
-		} else {
-			location.Latitude = float32(lat)
-			location.Longitude = float32(log)
-		}
-	}
-
-	// Calculate SVG coordinates using Robinson projection
-	location.SvgX, location.SvgY = latLongToSVG(location.Latitude, location.Longitude)
-
-	return location
-}
-
-// Robinson projection lookup table
-// Each entry: latitude (degrees), plen (parallel length), pdfe (distance from equator)
-var robinsonTable = []struct {
-	lat  float32
-	plen float32
-	pdfe float32
-}{
-	{0, 1.0000, 0.0000},
-	{5, 0.9986, 0.0620},
-	{10, 0.9954, 0.1240},
-	{15, 0.9900, 0.1860},
-	{20, 0.9822, 0.2480},
-	{25, 0.9730, 0.3100},
-	{30, 0.9600, 0.3720},
-	{35, 0.9427, 0.4340},
-	{40, 0.9216, 0.4958},
-	{45, 0.8962, 0.5571},
-	{50, 0.8679, 0.6176},
-	{55, 0.8350, 0.6769},
-	{60, 0.7986, 0.7346},
-	{65, 0.7597, 0.7903},
-	{70, 0.7186, 0.8435},
-	{75, 0.6732, 0.8936},
-	{80, 0.6213, 0.9394},
-	{85, 0.5722, 0.9761},
-	{90, 0.5322, 1.0000},
-}
-
-// SVG calibration constants for Simplemaps 2000x857 Robinson projection map
-const (
-	svgCenterX    = float32(986)  // X coordinate of longitude 0
-	svgEquatorY   = float32(497)  // Y coordinate of equator
-	svgEastScale  = float32(1020) // Pixels from centerX to 180°E (plen=1)
-	svgWestScale  = float32(1000) // Pixels from centerX to 180°W (plen=1)
-	svgNorthScale = float32(511)  // Pixels from equator to ~83°N
-	svgSouthScale = float32(528)  // Pixels from equator to ~55°S
-)
-
-// latLongToSVG converts latitude/longitude to SVG coordinates using Robinson projection
-func latLongToSVG(lat, lon float32) (float32, float32) {
-	// Get absolute latitude for table lookup
-	absLat := lat
-	if absLat < 0 {
-		absLat = -absLat
-	}
-
-	// Interpolate Robinson parameters
-	var plen, pdfe float32
-	if absLat >= 90 {
-		plen = 0.5322
-		pdfe = 1.0000
-	} else {
-		idx := int(absLat / 5)
-		t := (absLat - float32(idx)*5) / 5
-		row1 := robinsonTable[idx]
-		nextIdx := idx + 1
-		if nextIdx > 18 {
-			nextIdx = 18
-		}
-		row2 := robinsonTable[nextIdx]
-		plen = row1.plen + t*(row2.plen-row1.plen)
-		pdfe = row1.pdfe + t*(row2.pdfe-row1.pdfe)
-	}
+// deviceAttributes returns the non empty equipment info attributes that are searchable and groupable in a query
+func deviceAttributes(info *types.EquipmentInfo) map[string]string {
+	attributes := make(map[string]string)
+	addAttribute(attributes, "vendor", info.Vendor)
+	addAttribute(attributes, "model", info.Model)
+	addAttribute(attributes, "serialNumber", info.SerialNumber)
+	addAttribute(attributes, "ipAddress", info.IpAddress)
+	addAttribute(attributes, "software", info.Software)
+	addAttribute(attributes, "version", info.Version)
+	return attributes
+}
 
-	// Calculate X coordinate
-	var xScale float32
-	if lon >= 0 {
-		xScale = svgEastScale
-	} else {
-		xScale = svgWestScale
+func addAttribute(attributes map[string]string, name, value string) {
+	if value != "" {
+		attributes[name] = value
 	}
-	x := svgCenterX + (lon/180)*xScale*plen
+}
 
-	// Calculate Y coordinate
-	var scale float32
-	var sign float32
-	if lat >= 0 {
-		scale = svgNorthScale
-		sign = 1
-	} else {
-		scale = svgSouthScale
-		sign = -1
+func nodeStatus(status types.DeviceStatus) l8topo.L8TopologyNodeStatus {
+	switch status {
+	case types.DeviceStatus_DEVICE_STATUS_ONLINE:
+		return l8topo.L8TopologyNodeStatus_NodeUp
+	case types.DeviceStatus_DEVICE_STATUS_OFFLINE:
+		return l8topo.L8TopologyNodeStatus_NodeDown
+	case types.DeviceStatus_DEVICE_STATUS_WARNING,
+		types.DeviceStatus_DEVICE_STATUS_CRITICAL,
+		types.DeviceStatus_DEVICE_STATUS_MAINTENANCE,
+		types.DeviceStatus_DEVICE_STATUS_PARTIAL:
+		return l8topo.L8TopologyNodeStatus_NodePartial
 	}
-	y := svgEquatorY - sign*pdfe*scale
-
-	return x, y
+	return l8topo.L8TopologyNodeStatus_InvalidNodeStatus
 }
 
 func (this *Layer1) IsConnected(aside, zside interface{}) (bool, l8topo.L8TopologyLinkDirection) {
@@ -230,6 +168,111 @@ func (this *Layer1) IsConnected(aside, zside interface{}) (bool, l8topo.L8Topolo
 	return false, l8topo.L8TopologyLinkDirection_InvalidDirection
 }
 
+func (this *Layer1) LinkStatus(aside, zside interface{}) l8topo.L8TopologyLinkStatus {
+	asidePort := aside.(*types.Port)
+	zsidePort := zside.(*types.Port)
+	if len(asidePort.Interfaces) == 0 || len(zsidePort.Interfaces) == 0 {
+		return l8topo.L8TopologyLinkStatus_InvalidStatus
+	}
+	if isPortUp(asidePort) && isPortUp(zsidePort) {
+		return l8topo.L8TopologyLinkStatus_Up
+	}
+	return l8topo.L8TopologyLinkStatus_Down
+}
+
+func (this *Layer1) LinkCapacity(aside, zside interface{}) uint64 {
+	asideSpeed := portSpeed(aside.(*types.Port))
+	zsideSpeed := portSpeed(zside.(*types.Port))
+	// The link runs at the speed of its slower side
+	if asideSpeed < zsideSpeed {
+		return asideSpeed
+	}
+	return zsideSpeed
+}
+
+// lagPattern matches a whole LAG/port-channel name of the common vendors,
+// e.g. "Port-channel10", "Bundle-Ether 3", "ae0", "lag-2", "Po10".
+// The short names take no space before their number, so free text such as "PO 4500123" is not a LAG.
+var lagPattern = regexp.MustCompile(`(?i)^(?:(port-channel|bundle-ether)\s?|(ae|lag|po)-?)(\d{1,5})$`)
+
+// LagOf returns the LAG/port-channel the port is a member of.
+// The port inventory has no aggregate field, so it is the port interface named as a LAG,
+// or else an interface description that is only the LAG name.
+func (this *Layer1) LagOf(elem interface{}) string {
+	port := elem.(*types.Port)
+	for _, intf := range port.Interfaces {
+		if lag := lagOf(intf.Name); lag != "" {
+			return lag
+		}
+	}
+	for _, intf := range port.Interfaces {
+		if lag := lagOf(strings.TrimSpace(intf.Description)); lag != "" {
+			return lag
+		}
+	}
+	return ""
+}
+
+// lagOf returns the normalized LAG name, e.g. "port-channel10", if the name is a LAG name
+func lagOf(name string) string {
+	match := lagPattern.FindStringSubmatch(name)
+	if match == nil {
+		return ""
+	}
+	return strings.ToLower(match[1]+match[2]) + match[3]
+}
+
+// LinkAttributes returns the media of the link, when both its ports have the same interface type
+func (this *Layer1) LinkAttributes(aside, zside interface{}) map[string]string {
+	asideMedia := portMedia(aside.(*types.Port))
+	zsideMedia := portMedia(zside.(*types.Port))
+	if asideMedia == "" || asideMedia != zsideMedia {
+		return nil
+	}
+	return map[string]string{"media": asideMedia}
+}
+
+// LinkEndpoint returns the port details of a link side, from its first interface.
+// A port has no name of its own, so only the interface name is set.
+func (this *Layer1) LinkEndpoint(elem interface{}) *l8topo.L8TopologyLinkEndpoint {
+	port := elem.(*types.Port)
+	endpoint := &l8topo.L8TopologyLinkEndpoint{}
+	endpoint.Speed = portSpeed(port)
+	if len(port.Interfaces) > 0 {
+		endpoint.InterfaceName = port.Interfaces[0].Name
+		endpoint.Mtu = port.Interfaces[0].Mtu
+	}
+	return endpoint
+}
+
+func portMedia(port *types.Port) string {
+	for _, intf := range port.Interfaces {
+		if intf.InterfaceType != types.InterfaceType_INTERFACE_TYPE_UNKNOWN {
+			return strings.ToLower(strings.TrimPrefix(intf.InterfaceType.String(), "INTERFACE_TYPE_"))
+		}
+	}
+	return ""
+}
+
+func isPortUp(port *types.Port) bool {
+	for _, intf := range port.Interfaces {
+		if strings.EqualFold(intf.Status, "up") {
+			return true
+		}
+	}
+	return false
+}
+
+func portSpeed(port *types.Port) uint64 {
+	var speed uint64
+	for _, intf := range port.Interfaces {
+		if intf.Speed > speed {
+			speed = intf.Speed
+		}
+	}
+	return speed
+}
+
 // simpleHash creates a simple hash from a string
 func simpleHash(s string) int {
 	hash := 0
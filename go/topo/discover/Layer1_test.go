@@ -0,0 +1,58 @@
+package discover
+
+import (
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/probler/go/types"
+)
+
+func TestLayer1LagOf(t *testing.T) {
+	tests := []struct {
+		name        string
+		intfName    string
+		description string
+		lag         string
+	}{
+		{name: "lag interface", intfName: "Port-channel10", lag: "port-channel10"},
+		{name: "bundle interface", intfName: "Bundle-Ether 3", lag: "bundle-ether3"},
+		{name: "short name", intfName: "ae0", lag: "ae0"},
+		// The interface name is preferred to the description
+		{name: "name over description", intfName: "lag-2", description: "Po7", lag: "lag2"},
+		{name: "description", intfName: "GigabitEthernet0/1", description: " Po10 ", lag: "po10"},
+		{name: "free text", intfName: "GigabitEthernet0/1", description: "PO 4500123"},
+		{name: "text around the lag", intfName: "GigabitEthernet0/1", description: "uplink to Port-channel10 on core"},
+		{name: "no lag", intfName: "GigabitEthernet0/1", description: "uplink"},
+	}
+	layer1 := &Layer1{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			port := &types.Port{Id: "1", Interfaces: []*types.Interface{{Name: test.intfName, Description: test.description}}}
+			if lag := layer1.LagOf(port); lag != test.lag {
+				t.Fatal("expected", test.lag, "got", lag)
+			}
+		})
+	}
+}
+
+func TestLayer1LinkDetails(t *testing.T) {
+	layer1 := &Layer1{}
+	aside := &types.Port{Id: "a1", Interfaces: []*types.Interface{{Name: "Gi0/1", Status: "up", Speed: 10000000000, Mtu: 9000,
+		InterfaceType: types.InterfaceType_INTERFACE_TYPE_10GIGE}}}
+	zside := &types.Port{Id: "z1", Interfaces: []*types.Interface{{Name: "Gi0/2", Status: "down", Speed: 1000000000,
+		InterfaceType: types.InterfaceType_INTERFACE_TYPE_10GIGE}}}
+	endpoint := layer1.LinkEndpoint(aside)
+	if endpoint.PortName != "" || endpoint.InterfaceName != "Gi0/1" || endpoint.Speed != 10000000000 || endpoint.Mtu != 9000 {
+		t.Fatal("unexpected endpoint", endpoint)
+	}
+	// The link runs at the speed of its slower side and is down if a side is down
+	if capacity := layer1.LinkCapacity(aside, zside); capacity != 1000000000 {
+		t.Fatal("unexpected capacity", capacity)
+	}
+	if status := layer1.LinkStatus(aside, zside); status != l8topo.L8TopologyLinkStatus_Down {
+		t.Fatal("unexpected status", status)
+	}
+	if attributes := layer1.LinkAttributes(aside, zside); attributes["media"] != "10gige" {
+		t.Fatal("unexpected attributes", attributes)
+	}
+}
@@ -0,0 +1,72 @@
+package discover
+
+import (
+	"github.com/saichler/l8topology/go/topo/topo_service"
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+// locator creates the geographic locations of a discovery, and reports the locations it could not resolve
+type locator struct {
+	logger ifs.ILogger
+	prefix string
+}
+
+func newLocator(name string, nic ifs.IVNic) locator {
+	this := locator{logger: nic.Resources().Logger(), prefix: "[" + name + "] "}
+	loaded, err := loadCities()
+	if err != nil {
+		this.logWarning(this.prefix, err.Error())
+	} else if !loaded {
+		this.logInfo(this.prefix, "No cities file was found, using the embedded cities")
+	}
+	return this
+}
+
+func (this *locator) createLocation(nodeLocation string, latitude, longitude float32) *l8topo.L8TopologyLocation {
+	location := &l8topo.L8TopologyLocation{}
+	location.Location = nodeLocation
+	location.Latitude = latitude
+	location.Longitude = longitude
+
+	if location.Latitude == 0 || location.Longitude == 0 {
+		resolved := ResolveLocation(nodeLocation)
+		if resolved.Resolution != l8topo.L8TopologyLocationResolution_Unresolved {
+			location.Latitude = resolved.Latitude
+			location.Longitude = resolved.Longitude
+		}
+		location.Resolution = resolved.Resolution
+		location.Confidence = resolved.Confidence
+		location.ResolvedAs = resolved.ResolvedAs
+		switch {
+		case resolved.Resolution == l8topo.L8TopologyLocationResolution_Unresolved:
+			this.logError(this.prefix, "Unknown coordinates for location ", nodeLocation)
+		case resolved.Confidence < topo_service.MinLocationConfidence:
+			this.logWarning(this.prefix, "Location ", nodeLocation, " was resolved as ", resolved.ResolvedAs,
+				" with a low confidence of ", resolved.Confidence)
+		}
+	} else {
+		location.Resolution = l8topo.L8TopologyLocationResolution_ProvidedResolution
+		location.Confidence = 1
+	}
+
+	return location
+}
+
+func (this *locator) logError(args ...interface{}) {
+	if this.logger != nil {
+		this.logger.Error(args...)
+	}
+}
+
+func (this *locator) logWarning(args ...interface{}) {
+	if this.logger != nil {
+		this.logger.Warning(args...)
+	}
+}
+
+func (this *locator) logInfo(args ...interface{}) {
+	if this.logger != nil {
+		this.logger.Info(args...)
+	}
+}
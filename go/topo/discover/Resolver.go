@@ -0,0 +1,317 @@
+package discover
+
+import (
+	"encoding/csv"
+	"errors"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+const (
+	// minFuzzyConfidence is the lowest similarity a fuzzy match is accepted with
+	minFuzzyConfidence = float32(0.75)
+	cityOnlyConfidence = float32(0.9)
+	countryConfidence  = float32(0.3)
+	fuzzyCandidates    = 20
+)
+
+// locationAliases maps a normalized alias to its location, siteCodes maps a normalized site code prefix,
+// e.g. "lon" of "LON-DC2", to its location
+var (
+	locationAliases = normalizedAliases(map[string]string{
+		"NYC":               "New York, United States",
+		"New York City":     "New York, United States",
+		"LA":                "Los Angeles, United States",
+		"SF":                "San Francisco, United States",
+		"DC":                "Washington, United States",
+		"Bombay":            "Mumbai, India",
+		"Saigon":            "Ho Chi Minh City, Vietnam",
+		"Peking":            "Beijing, China",
+		"Kiev":              "Kyiv, Ukraine",
+		"Bengaluru":         "Bangalore, India",
+		"Frankfurt am Main": "Frankfurt, Germany",
+	})
+	siteCodes = normalizedAliases(map[string]string{
+		"NYC": "New York, United States",
+		"LAX": "Los Angeles, United States",
+		"SFO": "San Francisco, United States",
+		"SJC": "San Jose, United States",
+		"CHI": "Chicago, United States",
+		"DFW": "Dallas, United States",
+		"IAD": "Ashburn, United States",
+		"ATL": "Atlanta, United States",
+		"MIA": "Miami, United States",
+		"SEA": "Seattle, United States",
+		"TOR": "Toronto, Canada",
+		"LON": "London, United Kingdom",
+		"PAR": "Paris, France",
+		"FRA": "Frankfurt, Germany",
+		"AMS": "Amsterdam, Netherlands",
+		"MAD": "Madrid, Spain",
+		"MIL": "Milan, Italy",
+		"STO": "Stockholm, Sweden",
+		"DUB": "Dublin, Ireland",
+		"ZRH": "Zürich, Switzerland",
+		"SIN": "Singapore, Singapore",
+		"HKG": "Hong Kong, Hong Kong",
+		"TYO": "Tokyo, Japan",
+		"SYD": "Sydney, Australia",
+		"SAO": "São Paulo, Brazil",
+		"BOM": "Mumbai, India",
+		"DXB": "Dubai, United Arab Emirates",
+		"JNB": "Johannesburg, South Africa",
+	})
+	aliasMutex sync.RWMutex
+)
+
+// AddLocationAlias resolves the alias, e.g. "NYC", as the location, e.g. "New York, United States"
+func AddLocationAlias(alias, location string) {
+	aliasMutex.Lock()
+	defer aliasMutex.Unlock()
+	locationAliases[normalizeLocation(alias)] = location
+}
+
+// AddSiteCode resolves the locations starting with the site code, e.g. "LON" of "LON-DC2", as the location
+func AddSiteCode(code, location string) {
+	aliasMutex.Lock()
+	defer aliasMutex.Unlock()
+	siteCodes[normalizeLocation(code)] = location
+}
+
+// LoadLocationAliases adds the aliases of a csv file of "alias","location" records
+func LoadLocationAliases(path string) error {
+	return loadAliasesFile(path, AddLocationAlias)
+}
+
+// LoadSiteCodes adds the site codes of a csv file of "code","location" records
+func LoadSiteCodes(path string) error {
+	return loadAliasesFile(path, AddSiteCode)
+}
+
+func loadAliasesFile(path string, add func(string, string)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return errors.New(path + ": " + err.Error())
+	}
+	for _, record := range records {
+		if len(record) < 2 {
+			continue
+		}
+		add(record[0], record[1])
+	}
+	return nil
+}
+
+func normalizedAliases(aliases map[string]string) map[string]string {
+	result := make(map[string]string, len(aliases))
+	for alias, location := range aliases {
+		result[normalizeLocation(alias)] = location
+	}
+	return result
+}
+
+// ResolveLocation returns the coordinates of a location, how it was resolved and the confidence of the resolution.
+// The resolvers are tried in order: exact and normalized city match, aliases, site codes,
+// fuzzy city match and finally the centroid of the country.
+func ResolveLocation(location string) *l8topo.L8TopologyLocation {
+	cityMutex.RLock()
+	if !citiesLoaded {
+		cityMutex.RUnlock()
+		// A cities file error still leaves the embedded cities loaded
+		loadCities()
+		cityMutex.RLock()
+	}
+	defer cityMutex.RUnlock()
+
+	resolved := &l8topo.L8TopologyLocation{Location: location, Resolution: l8topo.L8TopologyLocationResolution_Unresolved}
+	key := normalizeLocation(location)
+	if key == "" {
+		return resolved
+	}
+
+	if coords, confidence, ok := cityOf(key); ok {
+		resolution := l8topo.L8TopologyLocationResolution_NormalizedResolution
+		if exactCities[exactKeyOf(location)] {
+			resolution = l8topo.L8TopologyLocationResolution_ExactResolution
+		}
+		return setResolution(resolved, coords, resolution, confidence, key)
+	}
+
+	aliasMutex.RLock()
+	alias, aliasOk := aliasOf(key)
+	site, siteOk := siteCodes[siteCodeOf(key)]
+	aliasMutex.RUnlock()
+	if aliasOk {
+		if coords, ok := aliasedLocationOf(alias); ok {
+			return setResolution(resolved, coords, l8topo.L8TopologyLocationResolution_AliasResolution, 1, alias)
+		}
+	}
+	if siteOk {
+		if coords, ok := aliasedLocationOf(site); ok {
+			return setResolution(resolved, coords, l8topo.L8TopologyLocationResolution_SiteCodeResolution, 1, site)
+		}
+	}
+
+	if match, confidence := fuzzyCityOf(key); confidence >= minFuzzyConfidence {
+		return setResolution(resolved, cityCoordinates[match], l8topo.L8TopologyLocationResolution_FuzzyResolution, confidence, match)
+	}
+
+	if coords, country, ok := countryOf(key); ok {
+		return setResolution(resolved, coords, l8topo.L8TopologyLocationResolution_CountryResolution, countryConfidence, country)
+	}
+	return resolved
+}
+
+func setResolution(location *l8topo.L8TopologyLocation, coords [2]float64, resolution l8topo.L8TopologyLocationResolution, confidence float32, resolvedAs string) *l8topo.L8TopologyLocation {
+	location.Longitude = float32(coords[0])
+	location.Latitude = float32(coords[1])
+	location.Resolution = resolution
+	location.Confidence = confidence
+	location.ResolvedAs = resolvedAs
+	return location
+}
+
+// cityOf finds a "City, AdminName, Country" key, falling back to "City, Country" and to the city alone
+func cityOf(key string) ([2]float64, float32, bool) {
+	if coords, ok := cityCoordinates[key]; ok {
+		return coords, 1, true
+	}
+	if coords, ok := cityNames[key]; ok {
+		return coords, cityOnlyConfidence, true
+	}
+	// The country may have commas in its name, e.g. "Korea, South", so every suffix is a country candidate
+	parts := strings.Split(key, ",")
+	for i := 2; i < len(parts); i++ {
+		if coords, ok := cityCoordinates[parts[0]+","+strings.Join(parts[i:], ",")]; ok {
+			return coords, 1, true
+		}
+	}
+	return [2]float64{}, 0, false
+}
+
+// countryOf finds the country of a key by its longest suffix, e.g. "Korea, South"
+func countryOf(key string) ([2]float64, string, bool) {
+	parts := strings.Split(key, ",")
+	for i := 0; i < len(parts); i++ {
+		country := strings.Join(parts[i:], ",")
+		if coords, ok := countryCoordinates[country]; ok {
+			return coords, country, true
+		}
+	}
+	return [2]float64{}, "", false
+}
+
+// aliasOf finds the alias of the whole key, or of its city part, e.g. "New York City" of "New York City, NY"
+func aliasOf(key string) (string, bool) {
+	if alias, ok := locationAliases[key]; ok {
+		return alias, true
+	}
+	city, _, ok := strings.Cut(key, ",")
+	if !ok {
+		return "", false
+	}
+	alias, ok := locationAliases[city]
+	return alias, ok
+}
+
+// aliasedLocationOf resolves the location an alias or a site code points to, a city or a country
+func aliasedLocationOf(location string) ([2]float64, bool) {
+	key := normalizeLocation(location)
+	if coords, _, ok := cityOf(key); ok {
+		return coords, true
+	}
+	coords, _, ok := countryOf(key)
+	return coords, ok
+}
+
+// siteCodeOf returns the leading letters of a site code, e.g. "lon" of "lon-dc2",
+// or an empty string if the key does not look like a site code
+func siteCodeOf(key string) string {
+	end := strings.IndexFunc(key, func(r rune) bool {
+		return r < 'a' || r > 'z'
+	})
+	if end == -1 {
+		return key
+	}
+	if next := key[end]; next == '-' || next == '_' || next == '.' || (next >= '0' && next <= '9') {
+		return key[:end]
+	}
+	return ""
+}
+
+// fuzzyCityOf returns the city key that is the most similar to the key, and its similarity.
+// Candidates sharing the most trigrams with the key are compared by their edit distance.
+func fuzzyCityOf(key string) (string, float32) {
+	shared := make(map[string]int)
+	for _, trigram := range trigramsOf(key) {
+		for _, candidate := range cityTrigrams[trigram] {
+			shared[candidate]++
+		}
+	}
+	candidates := make([]string, 0, len(shared))
+	for candidate := range shared {
+		candidates = append(candidates, candidate)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if shared[candidates[i]] != shared[candidates[j]] {
+			return shared[candidates[i]] > shared[candidates[j]]
+		}
+		return candidates[i] < candidates[j]
+	})
+	if len(candidates) > fuzzyCandidates {
+		candidates = candidates[:fuzzyCandidates]
+	}
+	best, bestSimilarity := "", float32(0)
+	for _, candidate := range candidates {
+		if similarity := similarityOf(key, candidate); similarity > bestSimilarity {
+			best, bestSimilarity = candidate, similarity
+		}
+	}
+	return best, bestSimilarity
+}
+
+func trigramsOf(key string) []string {
+	runes := []rune("  " + key + " ")
+	trigrams := make([]string, 0, len(runes))
+	for i := 0; i+3 <= len(runes); i++ {
+		trigrams = append(trigrams, string(runes[i:i+3]))
+	}
+	return trigrams
+}
+
+// similarityOf is one minus the Levenshtein distance of the two strings relative to the longer one
+func similarityOf(a, b string) float32 {
+	ra, rb := []rune(a), []rune(b)
+	longer := max(len(ra), len(rb))
+	if longer == 0 {
+		return 1
+	}
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return 1 - float32(previous[len(rb)])/float32(longer)
+}
@@ -0,0 +1,33 @@
+package discover
+
+import (
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+func TestResolveLocation(t *testing.T) {
+	resolutions := map[string]l8topo.L8TopologyLocationResolution{
+		"São Paulo, Brazil":                 l8topo.L8TopologyLocationResolution_ExactResolution,
+		"  sao  paulo , , BRAZIL":           l8topo.L8TopologyLocationResolution_NormalizedResolution,
+		"New York City, NY":                 l8topo.L8TopologyLocationResolution_AliasResolution,
+		"LON-DC2":                           l8topo.L8TopologyLocationResolution_SiteCodeResolution,
+		"Frankfrut, Germany":                l8topo.L8TopologyLocationResolution_FuzzyResolution,
+		"Smallville, Kansas, United States": l8topo.L8TopologyLocationResolution_CountryResolution,
+		"Nowhere, Atlantis":                 l8topo.L8TopologyLocationResolution_Unresolved,
+	}
+	for location, expected := range resolutions {
+		if resolved := ResolveLocation(location); resolved.Resolution != expected {
+			t.Fatal(location, "expected", expected, "got", resolved.Resolution, resolved.ResolvedAs)
+		}
+	}
+	AddSiteCode("XQZ", "Lima, Peru")
+	t.Cleanup(func() {
+		aliasMutex.Lock()
+		defer aliasMutex.Unlock()
+		delete(siteCodes, normalizeLocation("XQZ"))
+	})
+	if resolved := ResolveLocation("xqz_pop1"); resolved.ResolvedAs != "Lima, Peru" || resolved.Confidence != 1 {
+		t.Fatal("expected the user defined site code to resolve, got", resolved.ResolvedAs)
+	}
+}
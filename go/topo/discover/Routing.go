@@ -0,0 +1,324 @@
+package discover
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/saichler/l8topology/go/topo/topo_service"
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+// RoutingDiscovery is the control plane topology of a routing protocol, from the neighbor tables of the
+// routing devices. A link is an OSPF or IS-IS adjacency, or a BGP session.
+type RoutingDiscovery struct {
+	locator
+	protocol             l8topo.L8RoutingProtocol
+	inventoryServiceName string
+	inventoryServiceArea byte
+}
+
+const (
+	RoutingServiceArea = byte(1)
+	OspfServiceName    = "OSPF"
+	IsisServiceName    = "ISIS"
+	BgpServiceName     = "BGP"
+)
+
+// upStates are the neighbor states of an established adjacency or session, 2-way is the normal state
+// between two OSPF routers that are not the designated routers of their segment
+var upStates = map[string]bool{"full": true, "2way": true, "2-way": true, "up": true, "established": true}
+
+// ActivateRouting activates the topology of a routing protocol, from the neighbor tables of the inventory service
+func ActivateRouting(protocol l8topo.L8RoutingProtocol, inventoryServiceName string, inventoryServiceArea byte, nic ifs.IVNic) {
+	routing := NewRoutingDiscovery(protocol, inventoryServiceName, inventoryServiceArea, nic)
+	sla := ifs.NewServiceLevelAgreement(&topo_service.TopoService{}, routingServiceName(protocol), RoutingServiceArea, true, nil)
+	sla.SetArgs(routing)
+	nic.Resources().Registry().Register(&l8topo.L8RoutingDeviceList{})
+	nic.Resources().Introspector().Decorators().AddPrimaryKeyDecorator(&l8topo.L8RoutingDevice{}, "Id")
+	nic.Resources().Services().Activate(sla, nic)
+}
+
+func NewRoutingDiscovery(protocol l8topo.L8RoutingProtocol, inventoryServiceName string, inventoryServiceArea byte, nic ifs.IVNic) *RoutingDiscovery {
+	return &RoutingDiscovery{locator: newLocator(routingServiceName(protocol), nic), protocol: protocol,
+		inventoryServiceName: inventoryServiceName, inventoryServiceArea: inventoryServiceArea}
+}
+
+func routingServiceName(protocol l8topo.L8RoutingProtocol) string {
+	switch protocol {
+	case l8topo.L8RoutingProtocol_OSPF:
+		return OspfServiceName
+	case l8topo.L8RoutingProtocol_ISIS:
+		return IsisServiceName
+	case l8topo.L8RoutingProtocol_BGP:
+		return BgpServiceName
+	}
+	return ""
+}
+
+func (this *RoutingDiscovery) ServiceName() string {
+	return this.inventoryServiceName
+}
+
+func (this *RoutingDiscovery) ServiceArea() byte {
+	return this.inventoryServiceArea
+}
+
+func (this *RoutingDiscovery) Query() string {
+	return "select * from L8RoutingDevice"
+}
+
+func (this *RoutingDiscovery) ModelTypeName() string {
+	return "L8RoutingAdjacency"
+}
+
+func (this *RoutingDiscovery) Name() string {
+	switch this.protocol {
+	case l8topo.L8RoutingProtocol_OSPF:
+		return "OSPF Adjacencies"
+	case l8topo.L8RoutingProtocol_ISIS:
+		return "IS-IS Adjacencies"
+	case l8topo.L8RoutingProtocol_BGP:
+		return "BGP Sessions"
+	}
+	return ""
+}
+
+func (this *RoutingDiscovery) Description() string {
+	switch this.protocol {
+	case l8topo.L8RoutingProtocol_OSPF:
+		return "OSPF adjacencies between the routers, by area"
+	case l8topo.L8RoutingProtocol_ISIS:
+		return "IS-IS adjacencies between the routers, by level"
+	case l8topo.L8RoutingProtocol_BGP:
+		return "BGP sessions between the routers, by autonomous system"
+	}
+	return ""
+}
+
+func (this *RoutingDiscovery) Projection() string {
+	return topo_service.RobinsonProjection
+}
+
+func (this *RoutingDiscovery) IdOf(elem interface{}) string {
+	return elem.(*l8topo.L8RoutingDevice).Id
+}
+
+func (this *RoutingDiscovery) LocationOf(elem interface{}) string {
+	return elem.(*l8topo.L8RoutingDevice).Location
+}
+
+func (this *RoutingDiscovery) NodeType(elem interface{}) l8topo.L8TopologyNodeType {
+	return l8topo.L8TopologyNodeType_ROUTER
+}
+
+func (this *RoutingDiscovery) ConvertToTopologyNode(elem interface{}) (*l8topo.L8TopologyNode, *l8topo.L8TopologyLocation) {
+	device := elem.(*l8topo.L8RoutingDevice)
+	node := &l8topo.L8TopologyNode{}
+	node.NodeId = device.Id
+	node.Name = device.Name
+	node.Location = device.Location
+	node.Type = this.NodeType(device)
+	node.Status = this.nodeStatus(device)
+	node.Attributes = this.routerAttributes(device)
+	location := this.createLocation(topo_service.GeoLocationOf(node.Location), device.Latitude, device.Longitude)
+	return node, location
+}
+
+// nodeStatus is up when the router has an established adjacency of the protocol,
+// and down when none of its adjacencies of the protocol is established
+func (this *RoutingDiscovery) nodeStatus(device *l8topo.L8RoutingDevice) l8topo.L8TopologyNodeStatus {
+	status := l8topo.L8TopologyNodeStatus_InvalidNodeStatus
+	for _, adjacency := range device.Adjacencies {
+		if adjacency.Protocol != this.protocol {
+			continue
+		}
+		if isAdjacencyUp(adjacency) {
+			return l8topo.L8TopologyNodeStatus_NodeUp
+		}
+		status = l8topo.L8TopologyNodeStatus_NodeDown
+	}
+	return status
+}
+
+// routerAttributes returns the identities of the router, to merge and map it with the inventory devices,
+// and its OSPF areas, IS-IS levels or BGP autonomous systems, to group the routers by them in a query
+func (this *RoutingDiscovery) routerAttributes(device *l8topo.L8RoutingDevice) map[string]string {
+	attributes := make(map[string]string)
+	addAttribute(attributes, "routerId", device.RouterId)
+	addAttribute(attributes, "ipAddress", device.IpAddress)
+	addAttribute(attributes, "serialNumber", device.SerialNumber)
+	values := make(map[string]bool)
+	for _, adjacency := range device.Adjacencies {
+		if adjacency.Protocol != this.protocol {
+			continue
+		}
+		switch this.protocol {
+		case l8topo.L8RoutingProtocol_OSPF:
+			values[adjacency.Area] = true
+		case l8topo.L8RoutingProtocol_ISIS:
+			values[isisLevelOf(adjacency.Level)] = true
+		case l8topo.L8RoutingProtocol_BGP:
+			values[asOf(adjacency.LocalAs)] = true
+		}
+	}
+	delete(values, "")
+	switch this.protocol {
+	case l8topo.L8RoutingProtocol_OSPF:
+		addAttribute(attributes, "area", joinedOf(values))
+	case l8topo.L8RoutingProtocol_ISIS:
+		addAttribute(attributes, "level", joinedOf(values))
+	case l8topo.L8RoutingProtocol_BGP:
+		addAttribute(attributes, "as", joinedOf(values))
+	}
+	return attributes
+}
+
+// IsConnected matches two adjacencies of the protocol when each names the other side as its neighbor.
+// When the neighbor of one side is unknown, e.g. a session that is down on one router, the other side is enough.
+func (this *RoutingDiscovery) IsConnected(aside, zside interface{}) (bool, l8topo.L8TopologyLinkDirection) {
+	asideAdjacency := aside.(*l8topo.L8RoutingAdjacency)
+	zsideAdjacency := zside.(*l8topo.L8RoutingAdjacency)
+	if asideAdjacency.Protocol != this.protocol || zsideAdjacency.Protocol != this.protocol {
+		return false, l8topo.L8TopologyLinkDirection_InvalidDirection
+	}
+	var connected bool
+	switch {
+	case !hasNeighbor(zsideAdjacency):
+		connected = isNeighborOf(asideAdjacency, zsideAdjacency)
+	case !hasNeighbor(asideAdjacency):
+		connected = isNeighborOf(zsideAdjacency, asideAdjacency)
+	default:
+		connected = isNeighborOf(asideAdjacency, zsideAdjacency) && isNeighborOf(zsideAdjacency, asideAdjacency)
+	}
+	if connected {
+		return true, l8topo.L8TopologyLinkDirection_Bidirectional
+	}
+	return false, l8topo.L8TopologyLinkDirection_InvalidDirection
+}
+
+func hasNeighbor(adjacency *l8topo.L8RoutingAdjacency) bool {
+	return adjacency.NeighborAddress != "" || adjacency.NeighborId != ""
+}
+
+// isNeighborOf matches the neighbor of an adjacency by its address when both addresses are known,
+// as parallel adjacencies between two routers differ only by address, or else by its router id
+func isNeighborOf(adjacency, other *l8topo.L8RoutingAdjacency) bool {
+	if adjacency.NeighborAddress != "" && other.LocalAddress != "" {
+		return addressOf(adjacency.NeighborAddress) == addressOf(other.LocalAddress)
+	}
+	return adjacency.NeighborId != "" && strings.EqualFold(adjacency.NeighborId, other.LocalId)
+}
+
+// addressOf returns an address without its prefix length, e.g. "10.0.0.1" of "10.0.0.1/30"
+func addressOf(address string) string {
+	index := strings.Index(address, "/")
+	if index >= 0 {
+		address = address[:index]
+	}
+	return strings.ToLower(strings.TrimSpace(address))
+}
+
+// LinkStatus is up when the adjacency is established on both routers, the capacity of an adjacency is unknown
+func (this *RoutingDiscovery) LinkStatus(aside, zside interface{}) l8topo.L8TopologyLinkStatus {
+	if isAdjacencyUp(aside.(*l8topo.L8RoutingAdjacency)) && isAdjacencyUp(zside.(*l8topo.L8RoutingAdjacency)) {
+		return l8topo.L8TopologyLinkStatus_Up
+	}
+	return l8topo.L8TopologyLinkStatus_Down
+}
+
+func (this *RoutingDiscovery) LinkCapacity(aside, zside interface{}) uint64 {
+	return 0
+}
+
+func isAdjacencyUp(adjacency *l8topo.L8RoutingAdjacency) bool {
+	return upStates[strings.ToLower(strings.TrimSpace(adjacency.State))]
+}
+
+// LinkAttributes returns the protocol, the area, level or autonomous systems, the metric and the state of an adjacency.
+// A value that differs between the two sides is shown as "aside/zside", e.g. a metric of "10/20".
+func (this *RoutingDiscovery) LinkAttributes(aside, zside interface{}) map[string]string {
+	asideAdjacency := aside.(*l8topo.L8RoutingAdjacency)
+	zsideAdjacency := zside.(*l8topo.L8RoutingAdjacency)
+	attributes := map[string]string{"protocol": strings.ToLower(this.protocol.String())}
+	switch this.protocol {
+	case l8topo.L8RoutingProtocol_OSPF:
+		addAttribute(attributes, "area", sidesOf(asideAdjacency.Area, zsideAdjacency.Area))
+	case l8topo.L8RoutingProtocol_ISIS:
+		addAttribute(attributes, "level", sidesOf(isisLevelOf(asideAdjacency.Level), isisLevelOf(zsideAdjacency.Level)))
+	case l8topo.L8RoutingProtocol_BGP:
+		asideAs := firstAsOf(asideAdjacency.LocalAs, zsideAdjacency.NeighborAs)
+		zsideAs := firstAsOf(zsideAdjacency.LocalAs, asideAdjacency.NeighborAs)
+		addAttribute(attributes, "asideAs", asOf(asideAs))
+		addAttribute(attributes, "zsideAs", asOf(zsideAs))
+		if asideAs != 0 && zsideAs != 0 {
+			attributes["session"] = "ebgp"
+			if asideAs == zsideAs {
+				attributes["session"] = "ibgp"
+			}
+		}
+	}
+	addAttribute(attributes, "metric", sidesOf(metricOf(asideAdjacency.Metric), metricOf(zsideAdjacency.Metric)))
+	addAttribute(attributes, "state", sidesOf(asideAdjacency.State, zsideAdjacency.State))
+	return attributes
+}
+
+// LinkEndpoint returns the interface of a link side, a BGP session between loopbacks has none
+func (this *RoutingDiscovery) LinkEndpoint(elem interface{}) *l8topo.L8TopologyLinkEndpoint {
+	adjacency := elem.(*l8topo.L8RoutingAdjacency)
+	return &l8topo.L8TopologyLinkEndpoint{InterfaceName: adjacency.InterfaceName}
+}
+
+func isisLevelOf(level int32) string {
+	switch level {
+	case 1:
+		return "L1"
+	case 2:
+		return "L2"
+	case 3:
+		return "L1L2"
+	}
+	return ""
+}
+
+func asOf(as uint32) string {
+	if as == 0 {
+		return ""
+	}
+	return strconv.FormatUint(uint64(as), 10)
+}
+
+func firstAsOf(as, other uint32) uint32 {
+	if as != 0 {
+		return as
+	}
+	return other
+}
+
+func metricOf(metric uint32) string {
+	if metric == 0 {
+		return ""
+	}
+	return strconv.FormatUint(uint64(metric), 10)
+}
+
+// sidesOf returns the value of both sides, or "aside/zside" when they differ
+func sidesOf(aside, zside string) string {
+	if aside == zside || zside == "" {
+		return aside
+	}
+	if aside == "" {
+		return zside
+	}
+	return aside + "/" + zside
+}
+
+func joinedOf(values map[string]bool) string {
+	list := make([]string, 0, len(values))
+	for value := range values {
+		list = append(list, value)
+	}
+	sort.Strings(list)
+	return strings.Join(list, ",")
+}
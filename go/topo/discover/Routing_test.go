@@ -0,0 +1,80 @@
+package discover
+
+import (
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+func TestRoutingDiscovery(t *testing.T) {
+	bgp := &RoutingDiscovery{protocol: l8topo.L8RoutingProtocol_BGP}
+	// r2 has no session back to r1, the session of r1 is enough to show it
+	r1 := &l8topo.L8RoutingAdjacency{Protocol: l8topo.L8RoutingProtocol_BGP, LocalId: "1.1.1.1", LocalAddress: "10.0.0.1/30",
+		NeighborAddress: "10.0.0.2", LocalAs: 65001, NeighborAs: 65002, State: "Established"}
+	r2 := &l8topo.L8RoutingAdjacency{Protocol: l8topo.L8RoutingProtocol_BGP, LocalId: "2.2.2.2", LocalAddress: "10.0.0.2/30", State: "Idle"}
+	if ok, _ := bgp.IsConnected(r2, r1); !ok {
+		t.Fatal("expected a session of one side to connect")
+	}
+	attributes := bgp.LinkAttributes(r1, r2)
+	if attributes["protocol"] != "bgp" || attributes["asideAs"] != "65001" || attributes["zsideAs"] != "65002" ||
+		attributes["session"] != "ebgp" || attributes["state"] != "Established/Idle" {
+		t.Fatal("unexpected bgp attributes", attributes)
+	}
+	if bgp.LinkStatus(r1, r2) != l8topo.L8TopologyLinkStatus_Down {
+		t.Fatal("expected a session that is not established on both sides to be down")
+	}
+
+	ospf := &RoutingDiscovery{protocol: l8topo.L8RoutingProtocol_OSPF}
+	if ok, _ := ospf.IsConnected(r1, r2); ok {
+		t.Fatal("expected the ospf topology not to connect bgp sessions")
+	}
+	a := &l8topo.L8RoutingAdjacency{Protocol: l8topo.L8RoutingProtocol_OSPF, LocalId: "1.1.1.1", NeighborId: "2.2.2.2",
+		Area: "0.0.0.0", Metric: 10, State: "FULL", InterfaceName: "ge-0/0/1.100"}
+	z := &l8topo.L8RoutingAdjacency{Protocol: l8topo.L8RoutingProtocol_OSPF, LocalId: "2.2.2.2", NeighborId: "1.1.1.1",
+		Area: "0.0.0.0", Metric: 20, State: "full"}
+	if ok, _ := ospf.IsConnected(a, z); !ok || ospf.LinkStatus(a, z) != l8topo.L8TopologyLinkStatus_Up {
+		t.Fatal("expected an up ospf adjacency by router ids")
+	}
+	attributes = ospf.LinkAttributes(a, z)
+	if attributes["area"] != "0.0.0.0" || attributes["metric"] != "10/20" {
+		t.Fatal("unexpected ospf attributes", attributes)
+	}
+	device := &l8topo.L8RoutingDevice{Id: "r1", RouterId: "1.1.1.1", Adjacencies: []*l8topo.L8RoutingAdjacency{a, r1,
+		{Protocol: l8topo.L8RoutingProtocol_OSPF, Area: "0.0.0.1", State: "Init"}}}
+	if attrs := ospf.routerAttributes(device); attrs["area"] != "0.0.0.0,0.0.0.1" || attrs["routerId"] != "1.1.1.1" {
+		t.Fatal("unexpected router attributes", attrs)
+	}
+	if ospf.nodeStatus(device) != l8topo.L8TopologyNodeStatus_NodeUp {
+		t.Fatal("expected a router with a full adjacency to be up")
+	}
+}
+
+func TestRoutingDiscoveryNeighbors(t *testing.T) {
+	ospf := &RoutingDiscovery{protocol: l8topo.L8RoutingProtocol_OSPF}
+	adjacency := func(local, neighbor, intf string) *l8topo.L8RoutingAdjacency {
+		return &l8topo.L8RoutingAdjacency{Protocol: l8topo.L8RoutingProtocol_OSPF, LocalId: local, NeighborId: neighbor,
+			InterfaceName: intf, State: "FULL"}
+	}
+	// a has two neighbors, b and c
+	aToB := adjacency("a", "b", "ge-0/0/1")
+	aToC := adjacency("a", "c", "ge-0/0/2")
+	bToA := adjacency("b", "a", "ge-0/0/1")
+	cToA := adjacency("c", "a", "ge-0/0/1")
+	tests := []struct {
+		name         string
+		aside, zside *l8topo.L8RoutingAdjacency
+		connected    bool
+	}{
+		{"a to b", aToB, bToA, true},
+		{"a to c", aToC, cToA, true},
+		{"a toward b is not c toward a", aToB, cToA, false},
+		{"a toward c is not b toward a", aToC, bToA, false},
+		{"one sided, the neighbor of c is unknown", aToC, adjacency("c", "", "ge-0/0/1"), true},
+		{"one sided, of another router", aToB, adjacency("c", "", "ge-0/0/1"), false},
+	}
+	for _, test := range tests {
+		if ok, _ := ospf.IsConnected(test.aside, test.zside); ok != test.connected {
+			t.Fatal(test.name, "expected connected", test.connected)
+		}
+	}
+}
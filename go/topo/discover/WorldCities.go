@@ -1,37 +1,100 @@
 package discover
 
 import (
+	"bytes"
+	_ "embed"
 	"encoding/csv"
+	"errors"
+	"io"
+	"io/fs"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
+	"unicode"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+// The embedded cities are a compact set of major cities in the worldcities.csv format,
+// the embedded countries are the country centroids used when a city is unknown
+var (
+	//go:embed data/cities.csv
+	embeddedCities []byte
+	//go:embed data/countries.csv
+	embeddedCountries []byte
 )
 
 var (
-	cityCoordinates map[string][2]float64
-	cityMutex       sync.RWMutex
-	citiesLoaded    bool
+	citiesFile         = "worldcities.csv"
+	cityCoordinates    map[string][2]float64
+	countryCoordinates map[string][2]float64
+	cityNames          map[string][2]float64
+	cityTrigrams       map[string][]string
+	exactCities        map[string]bool
+	cityMutex          sync.RWMutex
+	citiesLoaded       bool
+	citiesFileLoaded   bool
+	citiesError        error
 )
 
-func loadCities() error {
+// SetCitiesFile sets the worldcities.csv formatted file that extends the embedded cities,
+// it takes effect on the next load
+func SetCitiesFile(path string) {
+	cityMutex.Lock()
+	defer cityMutex.Unlock()
+	citiesFile = path
+	citiesLoaded = false
+}
+
+// loadCities loads the embedded cities and countries, and the cities file if it exists,
+// and returns whether the cities file was loaded.
+// The cities file is optional, only an unreadable or invalid one is an error, the embedded cities are still loaded.
+func loadCities() (bool, error) {
 	cityMutex.Lock()
 	defer cityMutex.Unlock()
 
 	if citiesLoaded {
-		return nil
+		return citiesFileLoaded, citiesError
 	}
 
 	cityCoordinates = make(map[string][2]float64)
+	countryCoordinates = make(map[string][2]float64)
+	cityNames = make(map[string][2]float64)
+	cityTrigrams = make(map[string][]string)
+	exactCities = make(map[string]bool)
+	citiesLoaded = true
+	citiesFileLoaded = false
+	citiesError = nil
+
+	if err := readCities(bytes.NewReader(embeddedCities)); err != nil {
+		citiesError = errors.New("embedded cities: " + err.Error())
+		return false, citiesError
+	}
+	if err := readCountries(bytes.NewReader(embeddedCountries)); err != nil {
+		citiesError = errors.New("embedded countries: " + err.Error())
+		return false, citiesError
+	}
 
-	file, err := os.Open("worldcities.csv")
+	file, err := os.Open(citiesFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
 	if err != nil {
-		return err
+		citiesError = errors.New("cities file " + citiesFile + " was not loaded, using the embedded cities: " + err.Error())
+		return false, citiesError
 	}
 	defer file.Close()
+	if err = readCities(file); err != nil {
+		citiesError = errors.New("cities file " + citiesFile + ": " + err.Error())
+		return false, citiesError
+	}
+	citiesFileLoaded = true
+	return true, nil
+}
 
-	reader := csv.NewReader(file)
-	records, err := reader.ReadAll()
+func readCities(reader io.Reader) error {
+	records, err := csv.NewReader(reader).ReadAll()
 	if err != nil {
 		return err
 	}
@@ -57,32 +120,143 @@ func loadCities() error {
 		if errLon == nil && errLat == nil {
 			coords := [2]float64{lon, lat}
 			// Add with full format: "City, AdminName, Country"
-			fullKey := city + ", " + adminName + ", " + country
-			cityCoordinates[fullKey] = coords
-			// Add with short format: "City, Country"
-			shortKey := city + ", " + country
-			cityCoordinates[shortKey] = coords
+			addCity(normalizeLocation(city+", "+adminName+", "+country), coords, true)
+			// Add with short format: "City, Country", the first city of a name in a country wins
+			addCity(normalizeLocation(city+", "+country), coords, false)
+			// Add with the city alone, the first city of a name wins
+			addCity(normalizeLocation(city), coords, false)
+			for _, name := range []string{city + "," + adminName + "," + country, city + "," + country, city} {
+				exactCities[exactKeyOf(name)] = true
+			}
 		}
 	}
+	return nil
+}
+
+func readCountries(reader io.Reader) error {
+	records, err := csv.NewReader(reader).ReadAll()
+	if err != nil {
+		return err
+	}
 
-	citiesLoaded = true
+	// CSV format: "country","iso2","iso3","lat","lng", a country is found by its name or codes
+	for i, record := range records {
+		if i == 0 || len(record) < 5 {
+			continue
+		}
+		lat, errLat := strconv.ParseFloat(strings.TrimSpace(record[3]), 64)
+		lon, errLon := strconv.ParseFloat(strings.TrimSpace(record[4]), 64)
+		if errLon != nil || errLat != nil {
+			continue
+		}
+		for _, name := range record[:3] {
+			if key := normalizeLocation(name); key != "" {
+				countryCoordinates[key] = [2]float64{lon, lat}
+			}
+		}
+	}
 	return nil
 }
 
+// addCity adds the coordinates of a city key, and indexes a new key for fuzzy matching
+func addCity(key string, coords [2]float64, replace bool) {
+	if key == "" {
+		return
+	}
+	indexed := strings.Contains(key, ",")
+	cities := cityCoordinates
+	if !indexed {
+		cities = cityNames
+	}
+	if _, ok := cities[key]; ok {
+		if replace {
+			cities[key] = coords
+		}
+		return
+	}
+	cities[key] = coords
+	if indexed {
+		for _, trigram := range trigramsOf(key) {
+			cityTrigrams[trigram] = append(cityTrigrams[trigram], key)
+		}
+	}
+}
+
+// exactKeyOf returns the key of a location as written, only in lower case and without spaces around commas
+func exactKeyOf(location string) string {
+	parts := strings.Split(strings.ToLower(location), ",")
+	for i, part := range parts {
+		parts[i] = strings.TrimSpace(part)
+	}
+	return strings.Join(parts, ",")
+}
+
+// GetCityCoordinates returns the coordinates of a location, resolved by the location resolver chain
 func GetCityCoordinates(cityName string) (longitude, latitude float64, found bool) {
-	cityMutex.RLock()
-	if !citiesLoaded {
-		cityMutex.RUnlock()
-		if err := loadCities(); err != nil {
-			return 0, 0, false
+	resolved := ResolveLocation(cityName)
+	return float64(resolved.Longitude), float64(resolved.Latitude), resolved.Resolution != l8topo.L8TopologyLocationResolution_Unresolved
+}
+
+// normalizeLocation returns the lookup key of a location, lower case without accents,
+// with single spaces and without empty parts, e.g. " São  Paulo, , Brazil" is "sao paulo,brazil"
+func normalizeLocation(location string) string {
+	parts := strings.Split(location, ",")
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		folded := strings.Builder{}
+		for _, r := range strings.ToLower(part) {
+			if unicode.Is(unicode.Mn, r) {
+				continue // combining accent
+			}
+			if fold, ok := accentFolds[r]; ok {
+				folded.WriteString(fold)
+				continue
+			}
+			if r == '’' || r == '`' {
+				r = '\''
+			}
+			folded.WriteRune(r)
+		}
+		normalized := strings.Join(strings.Fields(folded.String()), " ")
+		if normalized != "" {
+			result = append(result, normalized)
 		}
-		cityMutex.RLock()
 	}
-	defer cityMutex.RUnlock()
+	return strings.Join(result, ",")
+}
 
-	coords, ok := cityCoordinates[cityName]
-	if !ok {
-		return 0, 0, false
+var accentFolds = buildAccentFolds(map[string]string{
+	"a":  "àáâãäåāăąǎ",
+	"c":  "çćĉċč",
+	"d":  "ďđð",
+	"e":  "èéêëēĕėęě",
+	"g":  "ĝğġģ",
+	"h":  "ĥħḩ",
+	"i":  "ìíîïĩīĭįıǐ",
+	"j":  "ĵ",
+	"k":  "ķ",
+	"l":  "ĺļľŀł",
+	"n":  "ñńņňŉ",
+	"o":  "òóôõöøōŏőǒ",
+	"r":  "ŕŗř",
+	"s":  "śŝşšș",
+	"t":  "ţťŧț",
+	"u":  "ùúûüũūŭůűųǔ",
+	"w":  "ŵ",
+	"y":  "ýÿŷ",
+	"z":  "źżžẓ",
+	"ss": "ß",
+	"ae": "æ",
+	"oe": "œ",
+	"th": "þ",
+})
+
+func buildAccentFolds(groups map[string]string) map[rune]string {
+	folds := make(map[rune]string)
+	for fold, runes := range groups {
+		for _, r := range runes {
+			folds[r] = fold
+		}
 	}
-	return coords[0], coords[1], true
+	return folds
 }
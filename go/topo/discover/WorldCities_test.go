@@ -0,0 +1,55 @@
+package discover
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+func TestLoadCities(t *testing.T) {
+	t.Cleanup(func() {
+		SetCitiesFile("worldcities.csv")
+	})
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.csv")
+	invalid := filepath.Join(dir, "invalid.csv")
+	files := map[string]string{
+		valid:   "city,city_ascii,lat,lng,country,iso2,iso3,admin_name\nQzville,Qzville,10.5,20.5,Atlantis,AT,ATL,Deep\n",
+		invalid: "city,\"unterminated\n",
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		name   string
+		file   string
+		loaded bool
+		err    bool
+	}{
+		// The cities file is optional
+		{name: "missing", file: filepath.Join(dir, "missing.csv")},
+		{name: "valid", file: valid, loaded: true},
+		{name: "invalid", file: invalid, err: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			SetCitiesFile(test.file)
+			loaded, err := loadCities()
+			if loaded != test.loaded || (err != nil) != test.err {
+				t.Fatal("expected loaded", test.loaded, "and error", test.err, "got", loaded, err)
+			}
+			// The embedded cities are loaded with or without the cities file
+			if resolved := ResolveLocation("Paris, France"); resolved.Resolution != l8topo.L8TopologyLocationResolution_ExactResolution {
+				t.Fatal("expected the embedded cities, got", resolved.Resolution)
+			}
+			resolved := ResolveLocation("Qzville, Atlantis")
+			if (resolved.Resolution == l8topo.L8TopologyLocationResolution_ExactResolution) != test.loaded {
+				t.Fatal("expected the cities file city to resolve only from a loaded file, got", resolved.Resolution)
+			}
+		})
+	}
+}
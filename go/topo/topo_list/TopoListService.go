@@ -39,10 +39,24 @@ func Activate(vnic ifs.IVNic) {
 	base.Activate(serviceConfig, vnic)
 }
 
+// AddTopology adds a topology to the list by its name only, see UpdateTopology for its full metadata
 func AddTopology(name, servicename string, area byte, vnic ifs.IVNic) {
-	tm := &l8topo.L8TopologyMetadata{Name: name, ServiceName: servicename, ServiceArea: int32(area)}
+	UpdateTopology(&l8topo.L8TopologyMetadata{Name: name, ServiceName: servicename, ServiceArea: int32(area)}, vnic)
+}
+
+// UpdateTopology adds or replaces the metadata of a topology, e.g. with the status of its last discovery
+func UpdateTopology(tm *l8topo.L8TopologyMetadata, vnic ifs.IVNic) {
+	tmService, ok := vnic.Resources().Services().ServiceHandler(ServiceName, ServiceArea)
+	if ok {
+		tmService.Put(object.New(nil, tm), vnic)
+	}
+}
+
+// RemoveTopology removes a topology from the list, when its service is deactivated
+func RemoveTopology(servicename string, area byte, vnic ifs.IVNic) {
+	tm := &l8topo.L8TopologyMetadata{ServiceName: servicename, ServiceArea: int32(area)}
 	tmService, ok := vnic.Resources().Services().ServiceHandler(ServiceName, ServiceArea)
 	if ok {
-		tmService.Post(object.New(nil, tm), vnic)
+		tmService.Delete(object.New(nil, tm), vnic)
 	}
 }
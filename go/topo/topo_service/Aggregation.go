@@ -0,0 +1,143 @@
+package topo_service
+
+import (
+	"strings"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+const (
+	unknownRegion    = "Unknown"
+	unknownAttribute = "Unknown"
+)
+
+// defaultDetailLevels are the aggregation levels of a zoomed Location view,
+// where the zoom is the projection map width divided by the bounding box width
+var defaultDetailLevels = []*l8topo.L8TopologyDetailLevel{
+	{MinZoom: 0, Aggregation: l8topo.L8TopologyAggregation_Country},
+	{MinZoom: 6, Aggregation: l8topo.L8TopologyAggregation_City},
+	{MinZoom: 25, Aggregation: l8topo.L8TopologyAggregation_Site},
+	{MinZoom: 100, Aggregation: l8topo.L8TopologyAggregation_Device},
+}
+
+// aggregationOf returns the aggregation level of the query on a map of the given width.
+// By default, a query with a group by attribute aggregates by that attribute,
+// a zoomed Location view picks the level of its zoom, from the query detail levels or the default ones,
+// the whole Location view aggregates the devices by site and the other layouts do not aggregate.
+func aggregationOf(tq *l8topo.L8TopologyQuery, mapWidth float32) l8topo.L8TopologyAggregation {
+	if tq.Aggregation != l8topo.L8TopologyAggregation_DefaultAggregation {
+		return tq.Aggregation
+	}
+	if tq.GroupByAttribute != "" {
+		return l8topo.L8TopologyAggregation_Attribute
+	}
+	if tq.Layout == l8topo.L8TopologyLayout_Location {
+		if hasBoundingBox(tq) || tq.Zoom > 0 {
+			levels := tq.DetailLevels
+			if len(levels) == 0 {
+				levels = defaultDetailLevels
+			}
+			return detailLevelOf(zoomOf(tq, mapWidth), levels)
+		}
+		return l8topo.L8TopologyAggregation_Site
+	}
+	return l8topo.L8TopologyAggregation_Device
+}
+
+// detailLevelOf returns the aggregation of the level with the highest minimum zoom that the zoom reached
+func detailLevelOf(zoom float32, levels []*l8topo.L8TopologyDetailLevel) l8topo.L8TopologyAggregation {
+	aggregation := l8topo.L8TopologyAggregation_Site
+	minZoom := float32(-1)
+	for _, level := range levels {
+		if zoom >= level.MinZoom && level.MinZoom > minZoom {
+			minZoom = level.MinZoom
+			aggregation = level.Aggregation
+		}
+	}
+	if aggregation == l8topo.L8TopologyAggregation_DefaultAggregation {
+		return l8topo.L8TopologyAggregation_Site
+	}
+	return aggregation
+}
+
+func hasBoundingBox(tq *l8topo.L8TopologyQuery) bool {
+	return tq.X != 0 || tq.Y != 0 || tq.X1 != 0 || tq.Y1 != 0
+}
+
+// aggregationKeyOf returns the key of the view node that the node is aggregated into
+func aggregationKeyOf(node *l8topo.L8TopologyNode, location *l8topo.L8TopologyLocation, aggregation l8topo.L8TopologyAggregation, groupBy string) string {
+	switch aggregation {
+	case l8topo.L8TopologyAggregation_Attribute:
+		value, ok := node.Attributes[groupBy]
+		if !ok || value == "" {
+			return unknownAttribute
+		}
+		return value
+	case l8topo.L8TopologyAggregation_Device:
+		return node.NodeId
+	case l8topo.L8TopologyAggregation_City:
+		city, _, country := parseLocation(GeoLocationOf(node.Location))
+		if city == country {
+			return city
+		}
+		return city + ", " + country
+	case l8topo.L8TopologyAggregation_Country:
+		_, _, country := parseLocation(GeoLocationOf(node.Location))
+		return country
+	case l8topo.L8TopologyAggregation_Region:
+		if location == nil {
+			return unknownRegion
+		}
+		return regionOf(location.Latitude, location.Longitude)
+	case l8topo.L8TopologyAggregation_Site:
+		return siteOf(node.Location)
+	}
+	return node.Location
+}
+
+// parseLocation splits a "City, AdminName, Country" location, the format of worldcities.csv, to its parts.
+// The country is the last part and the city is the first, anything in between is the admin name.
+func parseLocation(location string) (string, string, string) {
+	parts := strings.Split(location, ",")
+	for i, part := range parts {
+		parts[i] = strings.TrimSpace(part)
+	}
+	city := parts[0]
+	country := parts[len(parts)-1]
+	admin := ""
+	if len(parts) > 2 {
+		admin = strings.Join(parts[1:len(parts)-1], ", ")
+	}
+	return city, admin, country
+}
+
+// regionOf returns the continent of a coordinate, approximated by latitude/longitude boxes
+func regionOf(latitude, longitude float32) string {
+	switch {
+	case latitude == 0 && longitude == 0:
+		return unknownRegion
+	case longitude < -25 && latitude >= 13:
+		return "North America"
+	case longitude < -25:
+		return "South America"
+	case longitude >= 110 && latitude < -10, longitude >= 150:
+		return "Oceania"
+	case latitude < 37 && longitude < 52 && (latitude < 12 || longitude <= 34):
+		return "Africa"
+	case latitude >= 35 && longitude < 60:
+		return "Europe"
+	}
+	return "Asia"
+}
+
+// aggregateNodeStatus returns the status of a view node aggregating both statuses,
+// Partial when some members are up and others are down
+func aggregateNodeStatus(status, memberStatus l8topo.L8TopologyNodeStatus) l8topo.L8TopologyNodeStatus {
+	if status == l8topo.L8TopologyNodeStatus_InvalidNodeStatus {
+		return memberStatus
+	}
+	if memberStatus == l8topo.L8TopologyNodeStatus_InvalidNodeStatus || status == memberStatus {
+		return status
+	}
+	return l8topo.L8TopologyNodeStatus_NodePartial
+}
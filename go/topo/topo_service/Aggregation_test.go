@@ -0,0 +1,115 @@
+package topo_service
+
+import (
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+func TestAggregationOf(t *testing.T) {
+	levels := []*l8topo.L8TopologyDetailLevel{
+		{MinZoom: 0, Aggregation: l8topo.L8TopologyAggregation_Region},
+		{MinZoom: 3, Aggregation: l8topo.L8TopologyAggregation_Device},
+	}
+	tests := []struct {
+		name        string
+		query       *l8topo.L8TopologyQuery
+		mapWidth    float32
+		aggregation l8topo.L8TopologyAggregation
+	}{
+		// Without a bounding box or a zoom the whole map aggregates by site, a box of the whole map is zoom 1
+		{name: "whole map", query: &l8topo.L8TopologyQuery{}, aggregation: l8topo.L8TopologyAggregation_Site},
+		{name: "whole map box", query: &l8topo.L8TopologyQuery{X1: svgWidth, Y1: svgHeight}, aggregation: l8topo.L8TopologyAggregation_Country},
+		{name: "city box", query: &l8topo.L8TopologyQuery{X: 1000, X1: 1200, Y1: 100}, aggregation: l8topo.L8TopologyAggregation_City},
+		// The zoom of a box is relative to the width of the projection map
+		{name: "city box of a smaller map", query: &l8topo.L8TopologyQuery{X: 100, X1: 300, Y1: 100}, mapWidth: 1000,
+			aggregation: l8topo.L8TopologyAggregation_Country},
+		{name: "site zoom", query: &l8topo.L8TopologyQuery{Zoom: 30}, aggregation: l8topo.L8TopologyAggregation_Site},
+		{name: "device box", query: &l8topo.L8TopologyQuery{X: 1000, X1: 1010, Y1: 10}, aggregation: l8topo.L8TopologyAggregation_Device},
+		{name: "query levels", query: &l8topo.L8TopologyQuery{DetailLevels: levels, Zoom: 1}, aggregation: l8topo.L8TopologyAggregation_Region},
+		{name: "query levels zoomed", query: &l8topo.L8TopologyQuery{DetailLevels: levels, Zoom: 4}, aggregation: l8topo.L8TopologyAggregation_Device},
+		{name: "explicit", query: &l8topo.L8TopologyQuery{Aggregation: l8topo.L8TopologyAggregation_City, Zoom: 200},
+			aggregation: l8topo.L8TopologyAggregation_City},
+		{name: "group by", query: &l8topo.L8TopologyQuery{GroupByAttribute: "role"}, aggregation: l8topo.L8TopologyAggregation_Attribute},
+		{name: "other layout", query: &l8topo.L8TopologyQuery{Layout: l8topo.L8TopologyLayout_Circular}, aggregation: l8topo.L8TopologyAggregation_Device},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mapWidth := test.mapWidth
+			if mapWidth == 0 {
+				mapWidth = svgWidth
+			}
+			if aggregation := aggregationOf(test.query, mapWidth); aggregation != test.aggregation {
+				t.Fatal("expected", test.aggregation, "got", aggregation)
+			}
+		})
+	}
+}
+
+func TestAggregationKeyOf(t *testing.T) {
+	node := &l8topo.L8TopologyNode{NodeId: "r1", Location: "Paris, Ile-de-France, France" + IndoorSeparator + "PAR-DC1" + IndoorSeparator + "Floor 2",
+		Attributes: map[string]string{"role": "core"}}
+	location := &l8topo.L8TopologyLocation{Latitude: 48.85, Longitude: 2.35}
+	tests := []struct {
+		name        string
+		aggregation l8topo.L8TopologyAggregation
+		location    *l8topo.L8TopologyLocation
+		groupBy     string
+		key         string
+	}{
+		{name: "device", aggregation: l8topo.L8TopologyAggregation_Device, key: "r1"},
+		{name: "site", aggregation: l8topo.L8TopologyAggregation_Site, key: "Paris, Ile-de-France, France" + IndoorSeparator + "PAR-DC1"},
+		{name: "city", aggregation: l8topo.L8TopologyAggregation_City, key: "Paris, France"},
+		{name: "country", aggregation: l8topo.L8TopologyAggregation_Country, key: "France"},
+		{name: "region", aggregation: l8topo.L8TopologyAggregation_Region, location: location, key: "Europe"},
+		{name: "region without location", aggregation: l8topo.L8TopologyAggregation_Region, key: unknownRegion},
+		{name: "attribute", aggregation: l8topo.L8TopologyAggregation_Attribute, groupBy: "role", key: "core"},
+		{name: "missing attribute", aggregation: l8topo.L8TopologyAggregation_Attribute, groupBy: "vendor", key: unknownAttribute},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if key := aggregationKeyOf(node, test.location, test.aggregation, test.groupBy); key != test.key {
+				t.Fatal("expected", test.key, "got", key)
+			}
+		})
+	}
+}
+
+func TestRegionOf(t *testing.T) {
+	tests := []struct {
+		latitude, longitude float32
+		region              string
+	}{
+		{latitude: 40.71, longitude: -74.01, region: "North America"},
+		{latitude: -23.55, longitude: -46.63, region: "South America"},
+		{latitude: 51.51, longitude: -0.13, region: "Europe"},
+		{latitude: 30.04, longitude: 31.24, region: "Africa"},
+		{latitude: -33.87, longitude: 151.21, region: "Oceania"},
+		{latitude: 35.68, longitude: 139.69, region: "Asia"},
+		{latitude: 0, longitude: 0, region: unknownRegion},
+	}
+	for _, test := range tests {
+		if region := regionOf(test.latitude, test.longitude); region != test.region {
+			t.Fatal(test.latitude, test.longitude, "expected", test.region, "got", region)
+		}
+	}
+}
+
+func TestAggregateNodeStatus(t *testing.T) {
+	up := l8topo.L8TopologyNodeStatus_NodeUp
+	down := l8topo.L8TopologyNodeStatus_NodeDown
+	invalid := l8topo.L8TopologyNodeStatus_InvalidNodeStatus
+	tests := []struct {
+		status, member, aggregated l8topo.L8TopologyNodeStatus
+	}{
+		{status: invalid, member: up, aggregated: up},
+		{status: up, member: invalid, aggregated: up},
+		{status: down, member: down, aggregated: down},
+		{status: up, member: down, aggregated: l8topo.L8TopologyNodeStatus_NodePartial},
+	}
+	for _, test := range tests {
+		if status := aggregateNodeStatus(test.status, test.member); status != test.aggregated {
+			t.Fatal(test.status, test.member, "expected", test.aggregated, "got", status)
+		}
+	}
+}
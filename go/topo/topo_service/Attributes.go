@@ -0,0 +1,59 @@
+package topo_service
+
+import (
+	"errors"
+	"regexp"
+)
+
+// copyAttributes returns a copy of the attributes, so a view element does not share them with the cache
+func copyAttributes(attributes map[string]string) map[string]string {
+	if len(attributes) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		result[key] = value
+	}
+	return result
+}
+
+// commonAttributes keeps only the attributes that have the same value in the member attributes,
+// so an aggregated view element shows what all its members share
+func commonAttributes(attributes, memberAttributes map[string]string) map[string]string {
+	for key, value := range attributes {
+		if memberValue, ok := memberAttributes[key]; !ok || memberValue != value {
+			delete(attributes, key)
+		}
+	}
+	if len(attributes) == 0 {
+		return nil
+	}
+	return attributes
+}
+
+// compileAttributePatterns compiles the attribute name to value pattern map of a query
+func compileAttributePatterns(patterns map[string]string) (map[string]*regexp.Regexp, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]*regexp.Regexp, len(patterns))
+	for name, pattern := range patterns {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, errors.New("invalid pattern for attribute " + name + ": " + err.Error())
+		}
+		result[name] = compiled
+	}
+	return result, nil
+}
+
+// matchAttributes returns true if every attribute pattern matches its attribute value,
+// a missing attribute has an empty value
+func matchAttributes(patterns map[string]*regexp.Regexp, attributes map[string]string) bool {
+	for name, pattern := range patterns {
+		if !pattern.MatchString(attributes[name]) {
+			return false
+		}
+	}
+	return true
+}
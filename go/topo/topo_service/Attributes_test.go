@@ -0,0 +1,68 @@
+package topo_service
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestCommonAttributes(t *testing.T) {
+	tests := []struct {
+		name             string
+		attributes       map[string]string
+		memberAttributes map[string]string
+		common           map[string]string
+	}{
+		{name: "same", attributes: map[string]string{"role": "core"}, memberAttributes: map[string]string{"role": "core"},
+			common: map[string]string{"role": "core"}},
+		{name: "different value", attributes: map[string]string{"role": "core", "vendor": "x"},
+			memberAttributes: map[string]string{"role": "edge", "vendor": "x"}, common: map[string]string{"vendor": "x"}},
+		{name: "missing", attributes: map[string]string{"role": "core"}, memberAttributes: nil},
+		{name: "none", attributes: nil, memberAttributes: map[string]string{"role": "core"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			common := commonAttributes(copyAttributes(test.attributes), test.memberAttributes)
+			if len(common) != len(test.common) {
+				t.Fatal("expected", test.common, "got", common)
+			}
+			for key, value := range test.common {
+				if common[key] != value {
+					t.Fatal("expected", test.common, "got", common)
+				}
+			}
+		})
+	}
+}
+
+func TestCopyAttributes(t *testing.T) {
+	attributes := map[string]string{"role": "core"}
+	copied := copyAttributes(attributes)
+	copied["role"] = "edge"
+	if attributes["role"] != "core" {
+		t.Fatal("expected the copy not to share the attributes")
+	}
+	if copyAttributes(map[string]string{}) != nil {
+		t.Fatal("expected no attributes to be copied as nil")
+	}
+}
+
+func TestMatchAttributes(t *testing.T) {
+	patterns := map[string]*regexp.Regexp{"role": regexp.MustCompile("^core$"), "site": regexp.MustCompile("^$")}
+	tests := []struct {
+		attributes map[string]string
+		match      bool
+	}{
+		{attributes: map[string]string{"role": "core"}, match: true},
+		{attributes: map[string]string{"role": "core", "site": "PAR"}},
+		{attributes: map[string]string{"role": "edge"}},
+		{attributes: nil},
+	}
+	for _, test := range tests {
+		if matchAttributes(patterns, test.attributes) != test.match {
+			t.Fatal(test.attributes, "expected a match to be", test.match)
+		}
+	}
+	if !matchAttributes(nil, nil) {
+		t.Fatal("expected no patterns to match")
+	}
+}
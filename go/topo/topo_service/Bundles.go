@@ -0,0 +1,155 @@
+package topo_service
+
+import (
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"google.golang.org/protobuf/proto"
+)
+
+// bundleLags replaces the physical links that are members of the same LAG/port-channel
+// with one logical link, lagKeys maps a member link id to its LAG key
+func bundleLags(links []*l8topo.L8TopologyLink, lagKeys map[string]string) []*l8topo.L8TopologyLink {
+	if len(lagKeys) == 0 {
+		return links
+	}
+	result := make([]*l8topo.L8TopologyLink, 0, len(links))
+	lags := make(map[string]*l8topo.L8TopologyLink)
+	for _, link := range links {
+		lagKey, ok := lagKeys[link.LinkId]
+		if !ok {
+			result = append(result, link)
+			continue
+		}
+		lag, ok := lags[lagKey]
+		if !ok {
+			// The LAG takes its sides from its first member, so its nodes can be resolved
+			lag = createLink(link.Aside, link.Zside, link.Direction)
+			lag.LinkId = lagKey
+			lag.Status = l8topo.L8TopologyLinkStatus_InvalidStatus
+			lags[lagKey] = lag
+			result = append(result, lag)
+		}
+		addLinkMember(lag, link.LinkId, link)
+		// The member ports are kept, so the metrics of the LAG are polled from its members
+		if link.AsideEndpoint != nil {
+			lag.MemberAsideEndpoints = append(lag.MemberAsideEndpoints, cloneEndpoint(link.AsideEndpoint))
+		}
+		if link.ZsideEndpoint != nil {
+			lag.MemberZsideEndpoints = append(lag.MemberZsideEndpoints, cloneEndpoint(link.ZsideEndpoint))
+		}
+	}
+	return result
+}
+
+// addLinkMember adds a link as a member of a bundle,
+// accumulating its member count, capacity, status, direction and sources
+func addLinkMember(bundle *l8topo.L8TopologyLink, memberId string, member *l8topo.L8TopologyLink) {
+	memberCount := member.MemberCount
+	if memberCount < 1 {
+		memberCount = 1
+	}
+	if len(bundle.MemberLinkIds) > 0 {
+		if bundle.Direction != member.Direction {
+			bundle.Direction = l8topo.L8TopologyLinkDirection_Bidirectional
+		}
+		bundle.Attributes = commonAttributes(bundle.Attributes, member.Attributes)
+		bundle.AsideEndpoint = mergeEndpoint(bundle.AsideEndpoint, member.AsideEndpoint)
+		bundle.ZsideEndpoint = mergeEndpoint(bundle.ZsideEndpoint, member.ZsideEndpoint)
+	} else {
+		bundle.Attributes = copyAttributes(member.Attributes)
+		bundle.AsideEndpoint = cloneEndpoint(member.AsideEndpoint)
+		bundle.ZsideEndpoint = cloneEndpoint(member.ZsideEndpoint)
+	}
+	bundle.MemberCount += memberCount
+	bundle.MemberLinkIds = append(bundle.MemberLinkIds, memberId)
+	for _, source := range member.Sources {
+		if !hasSource(bundle.Sources, source) {
+			bundle.Sources = append(bundle.Sources, source)
+		}
+	}
+	mergeLinkMetrics(bundle, member)
+	bundle.Capacity += member.Capacity
+	// A link without a known status is considered up, as links always were
+	memberStatus := member.Status
+	if memberStatus == l8topo.L8TopologyLinkStatus_InvalidStatus {
+		memberStatus = l8topo.L8TopologyLinkStatus_Up
+	}
+	bundle.Status = aggregateLinkStatus(bundle.Status, memberStatus)
+}
+
+// aggregateLinkStatus returns the status of a bundle that has both statuses,
+// Partial when some members are up and others are down
+func aggregateLinkStatus(status, memberStatus l8topo.L8TopologyLinkStatus) l8topo.L8TopologyLinkStatus {
+	if status == l8topo.L8TopologyLinkStatus_InvalidStatus {
+		return memberStatus
+	}
+	if status == memberStatus {
+		return status
+	}
+	return l8topo.L8TopologyLinkStatus_Partial
+}
+
+// mergeLinks merges a view link into another view link between the same view nodes
+func mergeLinks(bundle, other *l8topo.L8TopologyLink) {
+	if bundle.Direction != other.Direction {
+		bundle.Direction = l8topo.L8TopologyLinkDirection_Bidirectional
+	}
+	bundle.MemberCount += other.MemberCount
+	bundle.MemberLinkIds = append(bundle.MemberLinkIds, other.MemberLinkIds...)
+	mergeLinkMetrics(bundle, other)
+	bundle.Capacity += other.Capacity
+	bundle.Status = aggregateLinkStatus(bundle.Status, other.Status)
+	bundle.Attributes = commonAttributes(bundle.Attributes, other.Attributes)
+	bundle.AsideEndpoint = mergeEndpoint(bundle.AsideEndpoint, other.AsideEndpoint)
+	bundle.ZsideEndpoint = mergeEndpoint(bundle.ZsideEndpoint, other.ZsideEndpoint)
+}
+
+func cloneEndpoint(endpoint *l8topo.L8TopologyLinkEndpoint) *l8topo.L8TopologyLinkEndpoint {
+	if endpoint == nil {
+		return nil
+	}
+	return proto.Clone(endpoint).(*l8topo.L8TopologyLinkEndpoint)
+}
+
+// mergeEndpoint merges a member endpoint into the endpoint of its bundle and returns it,
+// the bundle keeps the port details only if all its members share them,
+// adds up the speeds, averages the utilization weighted by speed and keeps the worst error rates.
+// A bundle without an endpoint yet starts from the member endpoint.
+func mergeEndpoint(endpoint, member *l8topo.L8TopologyLinkEndpoint) *l8topo.L8TopologyLinkEndpoint {
+	if member == nil {
+		return endpoint
+	}
+	if endpoint == nil {
+		return cloneEndpoint(member)
+	}
+	if endpoint.PortId != member.PortId {
+		endpoint.PortId = ""
+	}
+	if endpoint.PortName != member.PortName {
+		endpoint.PortName = ""
+	}
+	if endpoint.InterfaceName != member.InterfaceName {
+		endpoint.InterfaceName = ""
+	}
+	if member.Mtu < endpoint.Mtu {
+		endpoint.Mtu = member.Mtu
+	}
+	speed := float32(endpoint.Speed + member.Speed)
+	if speed > 0 {
+		endpoint.InUtilization = (endpoint.InUtilization*float32(endpoint.Speed) + member.InUtilization*float32(member.Speed)) / speed
+		endpoint.OutUtilization = (endpoint.OutUtilization*float32(endpoint.Speed) + member.OutUtilization*float32(member.Speed)) / speed
+	}
+	endpoint.InErrorRate = max(endpoint.InErrorRate, member.InErrorRate)
+	endpoint.OutErrorRate = max(endpoint.OutErrorRate, member.OutErrorRate)
+	endpoint.Speed += member.Speed
+	return endpoint
+}
+
+// setEndpointNodes sets the endpoint nodes of a view link to its view nodes
+func setEndpointNodes(link *l8topo.L8TopologyLink, aside, zside string) {
+	if link.AsideEndpoint != nil {
+		link.AsideEndpoint.NodeId = aside
+	}
+	if link.ZsideEndpoint != nil {
+		link.ZsideEndpoint.NodeId = zside
+	}
+}
@@ -0,0 +1,86 @@
+package topo_service
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+func TestBundleLags(t *testing.T) {
+	up := l8topo.L8TopologyLinkStatus_Up
+	down := l8topo.L8TopologyLinkStatus_Down
+	tests := []struct {
+		name     string
+		statuses []l8topo.L8TopologyLinkStatus
+		lagKeys  map[string]string
+		links    int
+		members  int32
+		capacity uint64
+		status   l8topo.L8TopologyLinkStatus
+	}{
+		{name: "no lags", statuses: []l8topo.L8TopologyLinkStatus{up, up, up}, links: 3},
+		{name: "one lag", statuses: []l8topo.L8TopologyLinkStatus{up, up, up},
+			lagKeys: map[string]string{"l0": "po1", "l1": "po1"}, links: 2, members: 2, capacity: 2000, status: up},
+		{name: "partial lag", statuses: []l8topo.L8TopologyLinkStatus{up, down, up},
+			lagKeys: map[string]string{"l0": "po1", "l1": "po1", "l2": "po1"}, links: 1, members: 3, capacity: 3000,
+			status: l8topo.L8TopologyLinkStatus_Partial},
+		{name: "down lag", statuses: []l8topo.L8TopologyLinkStatus{down, down, up},
+			lagKeys: map[string]string{"l0": "po1", "l1": "po1"}, links: 2, members: 2, capacity: 2000, status: down},
+		// A member without a known status is up
+		{name: "unknown status", statuses: []l8topo.L8TopologyLinkStatus{l8topo.L8TopologyLinkStatus_InvalidStatus, up, up},
+			lagKeys: map[string]string{"l0": "po1", "l1": "po1"}, links: 2, members: 2, capacity: 2000, status: up},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			links := make([]*l8topo.L8TopologyLink, 0, len(test.statuses))
+			for i, status := range test.statuses {
+				link := createLink("a", "z", l8topo.L8TopologyLinkDirection_Bidirectional)
+				link.LinkId = "l" + strconv.Itoa(i)
+				link.Status = status
+				link.Capacity = 1000
+				link.AsideEndpoint = &l8topo.L8TopologyLinkEndpoint{NodeId: "a", PortId: link.LinkId, Speed: 1000}
+				links = append(links, link)
+			}
+			bundled := bundleLags(links, test.lagKeys)
+			if len(bundled) != test.links {
+				t.Fatal("expected", test.links, "links, got", len(bundled))
+			}
+			if test.members == 0 {
+				return
+			}
+			lag := bundled[0]
+			if lag.LinkId != "po1" || lag.MemberCount != test.members || int32(len(lag.MemberLinkIds)) != test.members {
+				t.Fatal("unexpected lag members", lag.LinkId, lag.MemberCount, lag.MemberLinkIds)
+			}
+			if lag.Capacity != test.capacity || lag.Status != test.status {
+				t.Fatal("unexpected lag capacity or status", lag.Capacity, lag.Status)
+			}
+			// The members are on different ports, the lag endpoint has their total speed and no port
+			if lag.AsideEndpoint.PortId != "" || lag.AsideEndpoint.Speed != test.capacity {
+				t.Fatal("unexpected lag endpoint", lag.AsideEndpoint)
+			}
+			if int32(len(lag.MemberAsideEndpoints)) != test.members || len(lag.MemberZsideEndpoints) != 0 {
+				t.Fatal("expected the member endpoints to be kept", len(lag.MemberAsideEndpoints), len(lag.MemberZsideEndpoints))
+			}
+		})
+	}
+}
+
+func TestMergeEndpoint(t *testing.T) {
+	// The first member has no endpoint, the bundle starts from the endpoint of the second
+	endpoint := mergeEndpoint(nil, &l8topo.L8TopologyLinkEndpoint{NodeId: "a", PortId: "1", Speed: 1000, InUtilization: 10, InErrorRate: 0.01})
+	member := &l8topo.L8TopologyLinkEndpoint{NodeId: "a", PortId: "2", Speed: 3000, InUtilization: 50, OutErrorRate: 0.02}
+	if merged := mergeEndpoint(endpoint, member); merged != endpoint {
+		t.Fatal("expected the bundle endpoint to be merged in place")
+	}
+	if endpoint.PortId != "" || endpoint.Speed != 4000 || endpoint.InUtilization != 40 {
+		t.Fatal("unexpected merged endpoint", endpoint)
+	}
+	if endpoint.InErrorRate != 0.01 || endpoint.OutErrorRate != 0.02 {
+		t.Fatal("expected the worst error rates of the members", endpoint.InErrorRate, endpoint.OutErrorRate)
+	}
+	if merged := mergeEndpoint(endpoint, nil); merged != endpoint || endpoint.Speed != 4000 {
+		t.Fatal("expected a member without an endpoint to leave the bundle endpoint")
+	}
+}
@@ -0,0 +1,107 @@
+package topo_service
+
+import (
+	"math"
+	"sort"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+const (
+	// Default canvas, the size of the world map
+	svgWidth      float32 = 2000
+	svgHeight     float32 = 857
+	canvasPadding float32 = 50
+)
+
+// setCanvas sets the topology canvas to the query target viewport,
+// defaulting to the world map size and keeping its aspect ratio when only one side is given.
+// A Location layout canvas is the map background of its projection.
+func setCanvas(topology *l8topo.L8Topology, tq *l8topo.L8TopologyQuery, projection IProjection) {
+	width := tq.Width
+	height := tq.Height
+	if tq.Layout == l8topo.L8TopologyLayout_Location {
+		topology.Width, topology.Height = projection.Size()
+		return
+	}
+	switch {
+	case width <= 0 && height <= 0:
+		width = svgWidth
+		height = svgHeight
+	case width <= 0:
+		width = height * svgWidth / svgHeight
+	case height <= 0:
+		height = width * svgHeight / svgWidth
+	}
+	topology.Width = width
+	topology.Height = height
+}
+
+// growCanvas grows the topology canvas to at least the required width and height,
+// as independent sides
+func growCanvas(topology *l8topo.L8Topology, requiredWidth, requiredHeight float32) {
+	if topology.Width < requiredWidth {
+		topology.Width = requiredWidth
+	}
+	if topology.Height < requiredHeight {
+		topology.Height = requiredHeight
+	}
+}
+
+// growCanvasToFit grows the topology canvas, keeping its aspect ratio,
+// so its shorter side is at least the required size
+func growCanvasToFit(topology *l8topo.L8Topology, required float32) {
+	shorter := float32(math.Min(float64(topology.Width), float64(topology.Height)))
+	if shorter >= required || shorter <= 0 {
+		return
+	}
+	scale := required / shorter
+	topology.Width *= scale
+	topology.Height *= scale
+}
+
+// fitCanvas grows the topology canvas to include every node location,
+// e.g. pinned nodes that were placed outside the computed layout.
+// A layout that is left of or above the canvas is moved into it first, the canvas origin is always 0,0.
+func fitCanvas(topology *l8topo.L8Topology) {
+	minX, minY := float32(0), float32(0)
+	for _, location := range topology.Locations {
+		minX = float32(math.Min(float64(minX), float64(location.SvgX)))
+		minY = float32(math.Min(float64(minY), float64(location.SvgY)))
+	}
+	dx, dy := float32(0), float32(0)
+	if minX < 0 {
+		dx = canvasPadding - minX
+	}
+	if minY < 0 {
+		dy = canvasPadding - minY
+	}
+	for _, location := range topology.Locations {
+		location.SvgX += dx
+		location.SvgY += dy
+		growCanvas(topology, location.SvgX+canvasPadding, location.SvgY+canvasPadding)
+	}
+}
+
+// sortedNodes returns the nodes ordered by id, so a layout places the same topology the same way on every query
+func sortedNodes(nodes map[string]*l8topo.L8TopologyNode) []*l8topo.L8TopologyNode {
+	list := make([]*l8topo.L8TopologyNode, 0, len(nodes))
+	for _, node := range nodes {
+		list = append(list, node)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].NodeId < list[j].NodeId
+	})
+	return list
+}
+
+func sortedLinks(links map[string]*l8topo.L8TopologyLink) []*l8topo.L8TopologyLink {
+	list := make([]*l8topo.L8TopologyLink, 0, len(links))
+	for _, link := range links {
+		list = append(list, link)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].LinkId < list[j].LinkId
+	})
+	return list
+}
@@ -0,0 +1,67 @@
+package topo_service
+
+import (
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+func TestSetCanvas(t *testing.T) {
+	projection, _ := projectionOf("robinson")
+	tests := []struct {
+		name          string
+		query         *l8topo.L8TopologyQuery
+		width, height float32
+	}{
+		{name: "default", query: &l8topo.L8TopologyQuery{Layout: l8topo.L8TopologyLayout_Circular}, width: svgWidth, height: svgHeight},
+		{name: "viewport", query: &l8topo.L8TopologyQuery{Layout: l8topo.L8TopologyLayout_Circular, Width: 800, Height: 600}, width: 800, height: 600},
+		{name: "width only", query: &l8topo.L8TopologyQuery{Layout: l8topo.L8TopologyLayout_Circular, Width: 1000}, width: 1000, height: 1000 * svgHeight / svgWidth},
+		{name: "height only", query: &l8topo.L8TopologyQuery{Layout: l8topo.L8TopologyLayout_Circular, Height: 857}, width: svgWidth, height: 857},
+		{name: "location layout", query: &l8topo.L8TopologyQuery{Layout: l8topo.L8TopologyLayout_Location, Width: 800}, width: svgWidth, height: svgHeight},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			topology := &l8topo.L8Topology{}
+			setCanvas(topology, test.query, projection)
+			if topology.Width != test.width || topology.Height != test.height {
+				t.Fatal("expected", test.width, test.height, "got", topology.Width, topology.Height)
+			}
+		})
+	}
+}
+
+func TestFitCanvas(t *testing.T) {
+	tests := []struct {
+		name          string
+		x, y          float32
+		width, height float32
+		fitX, fitY    float32
+	}{
+		{name: "inside", x: 100, y: 100, width: 800, height: 600, fitX: 100, fitY: 100},
+		{name: "right and below", x: 1000, y: 700, width: 1050, height: 750, fitX: 1000, fitY: 700},
+		// The layout is moved, so the pinned node is at the padding and the other node keeps its distance
+		{name: "left", x: -600, y: 100, width: 1100, height: 600, fitX: 50, fitY: 100},
+		{name: "above", x: 100, y: -400, width: 800, height: 800, fitX: 100, fitY: 50},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			topology := &l8topo.L8Topology{Width: 800, Height: 600}
+			topology.Locations = map[string]*l8topo.L8TopologyLocation{
+				"pinned": {Location: "pinned", SvgX: test.x, SvgY: test.y},
+				"other":  {Location: "other", SvgX: 400, SvgY: 300},
+			}
+			fitCanvas(topology)
+			pinned := topology.Locations["pinned"]
+			if pinned.SvgX != test.fitX || pinned.SvgY != test.fitY {
+				t.Fatal("expected the node at", test.fitX, test.fitY, "got", pinned.SvgX, pinned.SvgY)
+			}
+			other := topology.Locations["other"]
+			if other.SvgX-pinned.SvgX != 400-test.x || other.SvgY-pinned.SvgY != 300-test.y {
+				t.Fatal("expected the layout to keep its shape", other.SvgX, other.SvgY)
+			}
+			if topology.Width != test.width || topology.Height != test.height {
+				t.Fatal("expected a canvas of", test.width, test.height, "got", topology.Width, topology.Height)
+			}
+		})
+	}
+}
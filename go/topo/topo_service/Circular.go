@@ -8,10 +8,12 @@ import (
 )
 
 const (
-	circularPadding float32 = 80
+	circularPadding        float32 = 80
+	circularMinNodeSpacing float32 = 60
+	circularMinRingSpacing float32 = 60
 )
 
-func Circular(topology *l8topo.L8Topology) {
+func Circular(topology *l8topo.L8Topology, pinned map[string]*l8topo.L8TopologyPinnedPosition) {
 	nodes := topology.GetNodes()
 	links := topology.GetLinks()
 
@@ -20,10 +22,6 @@ func Circular(topology *l8topo.L8Topology) {
 		return
 	}
 
-	centerX := svgWidth / 2
-	centerY := svgHeight / 2
-	maxRadius := float32(math.Min(float64(svgWidth), float64(svgHeight)))/2 - circularPadding
-
 	// Build adjacency list for sorting
 	adjacency := make(map[string]map[string]bool)
 	for _, node := range nodes {
@@ -39,36 +37,33 @@ func Circular(topology *l8topo.L8Topology) {
 		}
 	}
 
-	// Create sorted node list by connection count (most connected first)
+	positions := make(map[string]struct{ x, y float32 })
+
+	// Pinned nodes keep their position
+	for nodeId, pin := range pinned {
+		if _, ok := nodes[nodeId]; ok {
+			positions[nodeId] = struct{ x, y float32 }{x: pin.SvgX, y: pin.SvgY}
+		}
+	}
+
+	// Create sorted list of the unpinned nodes by connection count (most connected first)
 	nodeList := make([]*l8topo.L8TopologyNode, 0, nodeCount)
-	for _, node := range nodes {
+	for _, node := range sortedNodes(nodes) {
+		if _, ok := positions[node.NodeId]; ok {
+			continue
+		}
 		nodeList = append(nodeList, node)
 	}
-	sort.Slice(nodeList, func(i, j int) bool {
+	sort.SliceStable(nodeList, func(i, j int) bool {
 		return len(adjacency[nodeList[i].NodeId]) > len(adjacency[nodeList[j].NodeId])
 	})
+	nodeCount = len(nodeList)
 
-	positions := make(map[string]struct{ x, y float32 })
-
-	if nodeCount == 1 {
-		// Single node at center
-		positions[nodeList[0].NodeId] = struct{ x, y float32 }{x: centerX, y: centerY}
-	} else if nodeCount <= 6 {
-		// Small number of nodes: single circle
-		radius := maxRadius * 0.6
-		for index, node := range nodeList {
-			angle := (2*math.Pi*float64(index)/float64(nodeCount)) - math.Pi/2
-			positions[node.NodeId] = struct{ x, y float32 }{
-				x: centerX + float32(float64(radius)*math.Cos(angle)),
-				y: centerY + float32(float64(radius)*math.Sin(angle)),
-			}
-		}
-	} else {
-		// Larger networks: concentric circles based on connectivity
-		// Most connected node at center, then rings outward
+	// Larger networks are placed in concentric circles based on connectivity,
+	// most connected node at center, then rings outward
+	var rings [][]*l8topo.L8TopologyNode
+	if nodeCount > 6 {
 		nodesPerRing := []int{1, 6, 12, 18, 24}
-
-		var rings [][]*l8topo.L8TopologyNode
 		nodeIndex := 0
 		ringIndex := 0
 		for nodeIndex < nodeCount {
@@ -79,20 +74,49 @@ func Circular(topology *l8topo.L8Topology) {
 			nodeIndex = endIndex
 			ringIndex++
 		}
+	}
+
+	// Grow the canvas so the rings are spaced and each ring has room for its nodes
+	requiredRadius := float32(0)
+	if nodeCount > 0 && nodeCount <= 6 {
+		requiredRadius = float32(nodeCount) * circularMinNodeSpacing / (2 * math.Pi * 0.6)
+	}
+	if nodeCount > 6 {
+		requiredRadius = float32(len(rings)) * circularMinRingSpacing
+		for rIndex, ringNodes := range rings {
+			radius := float32(len(ringNodes)) * circularMinNodeSpacing / (2 * math.Pi * (float32(rIndex)/float32(len(rings)) + 0.2))
+			if radius > requiredRadius {
+				requiredRadius = radius
+			}
+		}
+	}
+	growCanvasToFit(topology, 2*(requiredRadius+circularPadding))
+
+	centerX := topology.Width / 2
+	centerY := topology.Height / 2
+	maxRadius := float32(math.Min(float64(topology.Width), float64(topology.Height)))/2 - circularPadding
 
+	if nodeCount == 1 && !isNearPinned(centerX, centerY, pinnedMinDistance, nodes, pinned) {
+		// Single node at center
+		positions[nodeList[0].NodeId] = struct{ x, y float32 }{x: centerX, y: centerY}
+	} else if nodeCount > 0 && nodeCount <= 6 {
+		// Small number of nodes: single circle
+		radius := maxRadius * 0.6
+		ring := ringPositions(centerX, centerY, radius, nodeCount, nodes, pinned)
+		for index, node := range nodeList {
+			positions[node.NodeId] = ring[index]
+		}
+	} else if nodeCount > 6 {
 		// Position nodes in each ring
 		for rIndex, ringNodes := range rings {
-			if rIndex == 0 && len(ringNodes) == 1 {
+			if rIndex == 0 && len(ringNodes) == 1 && !isNearPinned(centerX, centerY, pinnedMinDistance, nodes, pinned) {
 				// Center node
 				positions[ringNodes[0].NodeId] = struct{ x, y float32 }{x: centerX, y: centerY}
 			} else {
 				ringRadius := (float32(rIndex)/float32(len(rings)))*maxRadius + (maxRadius * 0.2)
+				ring := ringPositions(centerX, centerY, ringRadius, len(ringNodes), nodes, pinned)
 				for nIndex, node := range ringNodes {
-					angle := (2*math.Pi*float64(nIndex)/float64(len(ringNodes))) - math.Pi/2
-					positions[node.NodeId] = struct{ x, y float32 }{
-						x: centerX + float32(float64(ringRadius)*math.Cos(angle)),
-						y: centerY + float32(float64(ringRadius)*math.Sin(angle)),
-					}
+					positions[node.NodeId] = ring[nIndex]
 				}
 			}
 		}
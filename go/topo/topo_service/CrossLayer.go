@@ -0,0 +1,277 @@
+package topo_service
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/l8types/go/ifs"
+	"google.golang.org/protobuf/proto"
+)
+
+// layerElements are the nodes, links and locations of a topology layer
+type layerElements struct {
+	name       string
+	projection string
+	nodes      map[string]*l8topo.L8TopologyNode
+	links      map[string]*l8topo.L8TopologyLink
+	locations  map[string]*l8topo.L8TopologyLocation
+}
+
+// layerIndex maps the nodes of a layer by their identities, and its links by the ports of their endpoints.
+// Layers share a port by a node identity and an interface or port name, e.g. "serialNumber=fox1234/ge-0/0/1".
+type layerIndex struct {
+	rules IdentityRules
+	layer *layerElements
+	nodes map[string]*l8topo.L8TopologyNode
+	ports map[string][]*l8topo.L8TopologyLink
+}
+
+// elementsOf returns the elements of the topology, as they were discovered
+func (this *TopoService) elementsOf() *layerElements {
+	layer := &layerElements{name: this.name, projection: this.projection}
+	layer.nodes = make(map[string]*l8topo.L8TopologyNode)
+	layer.links = make(map[string]*l8topo.L8TopologyLink)
+	layer.locations = make(map[string]*l8topo.L8TopologyLocation)
+	all := func(i interface{}) (bool, interface{}) {
+		return true, i
+	}
+	for _, n := range this.nodes.Collect(all) {
+		node := n.(*l8topo.L8TopologyNode)
+		layer.nodes[node.NodeId] = node
+	}
+	for _, l := range this.links.Collect(all) {
+		link := l.(*l8topo.L8TopologyLink)
+		layer.links[link.LinkId] = link
+	}
+	for _, l := range this.locations.Collect(all) {
+		location := l.(*l8topo.L8TopologyLocation)
+		layer.locations[location.Location] = location
+	}
+	return layer
+}
+
+// layerOf returns the elements of another topology layer, from its service if it is local,
+// or else from a raw query of its leader, with the links as they were discovered and not bundled into view links
+func (this *TopoService) layerOf(serviceName string, serviceArea byte) (*layerElements, error) {
+	handler, ok := this.vnic.Resources().Services().ServiceHandler(serviceName, serviceArea)
+	if ok {
+		other, ok := handler.(*TopoService)
+		if !ok {
+			return nil, errors.New(serviceName + " is not a topology service")
+		}
+		return other.elementsOf(), nil
+	}
+	tq := &l8topo.L8TopologyQuery{Raw: true}
+	resp := this.vnic.LeaderRequest(serviceName, serviceArea, ifs.GET, tq, 30)
+	if resp == nil {
+		return nil, errors.New("no response from topology " + serviceName)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+	topology, ok := resp.Element().(*l8topo.L8Topology)
+	if !ok {
+		return nil, errors.New(serviceName + " did not respond with a topology")
+	}
+	return &layerElements{name: topology.Name, projection: topology.Projection,
+		nodes: topology.Nodes, links: topology.Links, locations: topology.Locations}, nil
+}
+
+// rawOf returns the nodes, links and locations of the topology as they were discovered, for another layer to map
+func (this *TopoService) rawOf() *l8topo.L8Topology {
+	layer := this.elementsOf()
+	return &l8topo.L8Topology{Name: layer.name, Projection: layer.projection,
+		Nodes: layer.nodes, Links: layer.links, Locations: layer.locations}
+}
+
+func newLayerIndex(layer *layerElements, rules IdentityRules) *layerIndex {
+	index := &layerIndex{rules: rules, layer: layer}
+	index.nodes = make(map[string]*l8topo.L8TopologyNode)
+	index.ports = make(map[string][]*l8topo.L8TopologyLink)
+	for _, node := range layer.nodes {
+		for _, identity := range nodeIdentitiesOf(node, rules) {
+			if _, ok := index.nodes[identity]; !ok {
+				index.nodes[identity] = node
+			}
+		}
+	}
+	for _, link := range layer.links {
+		for _, endpoint := range []*l8topo.L8TopologyLinkEndpoint{link.AsideEndpoint, link.ZsideEndpoint} {
+			for _, port := range portKeysOf(layer.nodes[endpointNodeIdOf(endpoint)], endpoint, rules) {
+				index.ports[port] = append(index.ports[port], link)
+			}
+		}
+	}
+	return index
+}
+
+func endpointNodeIdOf(endpoint *l8topo.L8TopologyLinkEndpoint) string {
+	if endpoint == nil {
+		return ""
+	}
+	return endpoint.NodeId
+}
+
+// nodeIdentitiesOf returns the identities of a node in any layer, its id and its identity rule values
+func nodeIdentitiesOf(node *l8topo.L8TopologyNode, rules IdentityRules) []string {
+	return append([]string{"id=" + node.NodeId}, identitiesOf(rules, node)...)
+}
+
+// portKeysOf returns the keys of the port of a link endpoint, by each identity of its node
+// and by its interface and port names. A sub-interface is on the port of its parent interface,
+// e.g. "ge-0/0/1.100" is on "ge-0/0/1".
+func portKeysOf(node *l8topo.L8TopologyNode, endpoint *l8topo.L8TopologyLinkEndpoint, rules IdentityRules) []string {
+	if endpoint == nil || endpoint.NodeId == "" {
+		return nil
+	}
+	if node == nil {
+		node = &l8topo.L8TopologyNode{NodeId: endpoint.NodeId}
+	}
+	names := make([]string, 0, 2)
+	for _, name := range []string{endpoint.InterfaceName, endpoint.PortName} {
+		name = parentInterfaceOf(strings.ToLower(strings.TrimSpace(name)))
+		if name != "" && (len(names) == 0 || names[0] != name) {
+			names = append(names, name)
+		}
+	}
+	keys := make([]string, 0)
+	for _, identity := range nodeIdentitiesOf(node, rules) {
+		for _, name := range names {
+			keys = append(keys, identity+"/"+name)
+		}
+	}
+	return keys
+}
+
+func parentInterfaceOf(name string) string {
+	index := strings.LastIndex(name, ".")
+	if index <= 0 {
+		return name
+	}
+	if _, err := strconv.Atoi(name[index+1:]); err != nil {
+		return name
+	}
+	return name[:index]
+}
+
+// linksOf returns the links of the layer that share a port with a link endpoint of another layer
+func (this *layerIndex) linksOf(node *l8topo.L8TopologyNode, endpoint *l8topo.L8TopologyLinkEndpoint) []*l8topo.L8TopologyLink {
+	result := make([]*l8topo.L8TopologyLink, 0)
+	found := make(map[string]bool)
+	for _, port := range portKeysOf(node, endpoint, this.rules) {
+		for _, link := range this.ports[port] {
+			if !found[link.LinkId] {
+				found[link.LinkId] = true
+				result = append(result, link)
+			}
+		}
+	}
+	return result
+}
+
+// nodeOf returns the node of the layer that is the node of another layer, by its first shared identity
+func (this *layerIndex) nodeOf(node *l8topo.L8TopologyNode) *l8topo.L8TopologyNode {
+	for _, identity := range nodeIdentitiesOf(node, this.rules) {
+		mapped, ok := this.nodes[identity]
+		if ok {
+			return mapped
+		}
+	}
+	return nil
+}
+
+// crossLayerOf returns the elements of another topology layer that map to the query links and nodes,
+// e.g. the physical links that carry an L3 adjacency, or the L3 adjacencies over a physical link.
+// Each mapped element lists the ids of the query elements it is mapped from.
+func (this *TopoService) crossLayerOf(tq *l8topo.L8TopologyQuery) (*l8topo.L8Topology, error) {
+	if len(tq.MapLinkIds) == 0 && len(tq.MapNodeIds) == 0 {
+		return nil, errors.New("cross layer query requires link or node ids to map")
+	}
+	if tq.MapServiceArea < 0 || tq.MapServiceArea > 255 {
+		return nil, errors.New("cross layer query service area out of range")
+	}
+	layer, err := this.layerOf(tq.MapServiceName, byte(tq.MapServiceArea))
+	if err != nil {
+		return nil, err
+	}
+	index := newLayerIndex(layer, this.rules)
+	topology := &l8topo.L8Topology{Name: layer.name, Projection: layer.projection}
+	topology.Nodes = make(map[string]*l8topo.L8TopologyNode)
+	topology.Links = make(map[string]*l8topo.L8TopologyLink)
+	topology.Locations = make(map[string]*l8topo.L8TopologyLocation)
+
+	for _, linkId := range tq.MapLinkIds {
+		l, err := this.links.Get(&l8topo.L8TopologyLink{LinkId: linkId})
+		if err != nil {
+			return nil, errors.New("unknown link " + linkId)
+		}
+		link := l.(*l8topo.L8TopologyLink)
+		for _, endpoint := range []*l8topo.L8TopologyLinkEndpoint{link.AsideEndpoint, link.ZsideEndpoint} {
+			for _, mapped := range index.linksOf(this.nodeOf(endpointNodeIdOf(endpoint)), endpoint) {
+				addMappedLink(topology, layer, mapped, linkId)
+			}
+		}
+	}
+	for _, nodeId := range tq.MapNodeIds {
+		node := this.nodeOf(nodeId)
+		if node == nil {
+			return nil, errors.New("unknown node " + nodeId)
+		}
+		mapped := index.nodeOf(node)
+		if mapped != nil {
+			addMappedNode(topology, layer, mapped, nodeId)
+		}
+	}
+	return topology, nil
+}
+
+func (this *TopoService) nodeOf(nodeId string) *l8topo.L8TopologyNode {
+	n, err := this.nodes.Get(&l8topo.L8TopologyNode{NodeId: nodeId})
+	if err != nil {
+		return nil
+	}
+	return n.(*l8topo.L8TopologyNode)
+}
+
+func addMappedLink(topology *l8topo.L8Topology, layer *layerElements, link *l8topo.L8TopologyLink, from string) {
+	mapped, ok := topology.Links[link.LinkId]
+	if !ok {
+		mapped = proto.Clone(link).(*l8topo.L8TopologyLink)
+		mapped.MappedFrom = nil
+		topology.Links[link.LinkId] = mapped
+	}
+	if !hasSource(mapped.MappedFrom, from) {
+		mapped.MappedFrom = append(mapped.MappedFrom, from)
+	}
+	// The link nodes are in the result so it can be drawn, they are not mapped
+	for _, nodeId := range []string{asideNodeIdOf(link), zsideNodeIdOf(link)} {
+		node, ok := layer.nodes[nodeId]
+		if ok {
+			addLayerNode(topology, layer, node)
+		}
+	}
+}
+
+func addMappedNode(topology *l8topo.L8Topology, layer *layerElements, node *l8topo.L8TopologyNode, from string) {
+	mapped := addLayerNode(topology, layer, node)
+	if !hasSource(mapped.MappedFrom, from) {
+		mapped.MappedFrom = append(mapped.MappedFrom, from)
+	}
+}
+
+func addLayerNode(topology *l8topo.L8Topology, layer *layerElements, node *l8topo.L8TopologyNode) *l8topo.L8TopologyNode {
+	added, ok := topology.Nodes[node.NodeId]
+	if ok {
+		return added
+	}
+	added = proto.Clone(node).(*l8topo.L8TopologyNode)
+	added.MappedFrom = nil
+	topology.Nodes[node.NodeId] = added
+	location, ok := layer.locations[node.Location]
+	if ok {
+		topology.Locations[location.Location] = location
+	}
+	return added
+}
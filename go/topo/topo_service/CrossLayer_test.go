@@ -0,0 +1,42 @@
+package topo_service
+
+import (
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+func TestCrossLayerIndex(t *testing.T) {
+	// Layer 1 knows the routers by their inventory ids, with their serial numbers
+	layer1 := &layerElements{
+		nodes: map[string]*l8topo.L8TopologyNode{
+			"dev-1": {NodeId: "dev-1", Attributes: map[string]string{"serialNumber": "SN1"}},
+			"dev-2": {NodeId: "dev-2", Attributes: map[string]string{"serialNumber": "SN2"}},
+		},
+		links: map[string]*l8topo.L8TopologyLink{
+			"fiber-1": {LinkId: "fiber-1",
+				AsideEndpoint: &l8topo.L8TopologyLinkEndpoint{NodeId: "dev-1", InterfaceName: "ge-0/0/1"},
+				ZsideEndpoint: &l8topo.L8TopologyLinkEndpoint{NodeId: "dev-2", InterfaceName: "ge-0/0/2"}},
+			"fiber-2": {LinkId: "fiber-2",
+				AsideEndpoint: &l8topo.L8TopologyLinkEndpoint{NodeId: "dev-1", InterfaceName: "ge-0/0/3"}},
+		},
+	}
+	index := newLayerIndex(layer1, DefaultIdentityRules)
+
+	// Layer 3 knows the router by its router id, and its adjacency is over a VLAN sub-interface
+	router := &l8topo.L8TopologyNode{NodeId: "10.0.0.1", Attributes: map[string]string{"serialNumber": "sn1"}}
+	endpoint := &l8topo.L8TopologyLinkEndpoint{NodeId: "10.0.0.1", InterfaceName: "GE-0/0/1.100"}
+	links := index.linksOf(router, endpoint)
+	if len(links) != 1 || links[0].LinkId != "fiber-1" {
+		t.Fatal("expected the adjacency to map to fiber-1", links)
+	}
+	if mapped := index.nodeOf(router); mapped == nil || mapped.NodeId != "dev-1" {
+		t.Fatal("expected the router to map to dev-1", mapped)
+	}
+	if links = index.linksOf(&l8topo.L8TopologyNode{NodeId: "10.0.0.9"}, endpoint); len(links) != 0 {
+		t.Fatal("expected no links of an unknown node", links)
+	}
+	if parentInterfaceOf("bundle-ether1.200") != "bundle-ether1" || parentInterfaceOf("1.1.1.x") != "1.1.1.x" {
+		t.Fatal("unexpected parent interfaces")
+	}
+}
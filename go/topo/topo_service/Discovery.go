@@ -0,0 +1,69 @@
+package topo_service
+
+import "github.com/saichler/l8topology/go/types/l8topo"
+
+// The optional interfaces of a discovery, a discovery that does not implement one gets the default of the topology
+
+// ILinkDetails is a discovery that knows the status and capacity of its links, a link is Up without capacity by default
+type ILinkDetails interface {
+	LinkStatus(aside, zside interface{}) l8topo.L8TopologyLinkStatus
+	LinkCapacity(aside, zside interface{}) uint64
+}
+
+// ILagDiscovery is a discovery that knows the LAG/port-channel of a link side element, to bundle its member links
+type ILagDiscovery interface {
+	LagOf(elem interface{}) string
+}
+
+// ILinkAttributes is a discovery that adds key/value attributes to its links
+type ILinkAttributes interface {
+	LinkAttributes(aside, zside interface{}) map[string]string
+}
+
+// IEndpointDiscovery is a discovery that knows the port details of a link side element
+type IEndpointDiscovery interface {
+	LinkEndpoint(elem interface{}) *l8topo.L8TopologyLinkEndpoint
+}
+
+// IProjected is a discovery of a topology with its own map projection, the Robinson projection by default
+type IProjected interface {
+	Projection() string
+}
+
+// IDescribed is a discovery with the name and description of its topology, its service name by default
+type IDescribed interface {
+	Name() string
+	Description() string
+}
+
+func projectionOfDiscovery(discovery ITopoDiscovery) string {
+	projected, ok := discovery.(IProjected)
+	if !ok || projected.Projection() == "" {
+		return RobinsonProjection
+	}
+	return projected.Projection()
+}
+
+func nameOf(discovery ITopoDiscovery) string {
+	described, ok := discovery.(IDescribed)
+	if !ok {
+		return ""
+	}
+	return described.Name()
+}
+
+func descriptionOf(discovery ITopoDiscovery) string {
+	described, ok := discovery.(IDescribed)
+	if !ok {
+		return ""
+	}
+	return described.Description()
+}
+
+func lagOf(discovery ITopoDiscovery, elem interface{}) string {
+	lags, ok := discovery.(ILagDiscovery)
+	if !ok {
+		return ""
+	}
+	return lags.LagOf(elem)
+}
@@ -0,0 +1,109 @@
+package topo_service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/l8types/go/ifs"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxDiscoveryReports is the number of the last discovery runs kept for query
+	maxDiscoveryReports = 20
+	// maxConversionErrors bounds the conversion errors of a report, in case all the elements fail
+	maxConversionErrors = 100
+	firstDiscoveryDelay = time.Second * 5
+	maxDiscoveryDelay   = time.Minute * 10
+)
+
+// reportsStore keeps the reports of the last discovery runs of the sources, the newest first
+type reportsStore struct {
+	mtx      sync.Mutex
+	reports  []*l8topo.L8TopologyDiscoveryReport
+	attempts map[string]int32
+}
+
+func newReportsStore() *reportsStore {
+	return &reportsStore{attempts: make(map[string]int32)}
+}
+
+func (this *reportsStore) start(source string) *l8topo.L8TopologyDiscoveryReport {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	this.attempts[source]++
+	return &l8topo.L8TopologyDiscoveryReport{Source: source, Attempt: this.attempts[source], StartTime: time.Now().UnixMilli()}
+}
+
+// end adds a finished report, a failed one starts the count of attempts of its source to the next success
+func (this *reportsStore) end(report *l8topo.L8TopologyDiscoveryReport, err error) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	report.EndTime = time.Now().UnixMilli()
+	if err != nil {
+		report.Error = err.Error()
+	} else {
+		this.attempts[report.Source] = 0
+	}
+	this.reports = append([]*l8topo.L8TopologyDiscoveryReport{report}, this.reports...)
+	if len(this.reports) > maxDiscoveryReports {
+		this.reports = this.reports[:maxDiscoveryReports]
+	}
+}
+
+// retryAt sets the time of the next attempt of the failed reports of a run
+func (this *reportsStore) retryAt(reports []*l8topo.L8TopologyDiscoveryReport, next time.Time) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	for _, report := range reports {
+		if report.Error != "" {
+			report.NextRetry = next.UnixMilli()
+		}
+	}
+}
+
+func (this *reportsStore) list() []*l8topo.L8TopologyDiscoveryReport {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	result := make([]*l8topo.L8TopologyDiscoveryReport, len(this.reports))
+	for i, report := range this.reports {
+		result[i] = proto.Clone(report).(*l8topo.L8TopologyDiscoveryReport)
+	}
+	return result
+}
+
+func addConversionError(report *l8topo.L8TopologyDiscoveryReport, err error) {
+	if len(report.ConversionErrors) < maxConversionErrors {
+		report.ConversionErrors = append(report.ConversionErrors, err.Error())
+	}
+}
+
+// discoveryReportsOf returns a topology of the reports of the last discovery runs
+func (this *TopoService) discoveryReportsOf() *l8topo.L8Topology {
+	return &l8topo.L8Topology{Name: this.name, DiscoveryReports: this.reports.list()}
+}
+
+// discover runs the discovery until all its sources succeed, retrying the failed sources with an exponential backoff
+func (this *TopoService) discover(vnic ifs.IVNic) {
+	delay := firstDiscoveryDelay
+	responded := make(map[string][]interface{})
+	for {
+		select {
+		case <-this.stop:
+			return
+		case <-time.After(delay):
+		}
+		reports, err := this.discoverSources(responded, vnic)
+		if err == nil {
+			return
+		}
+		delay = nextDiscoveryDelay(delay)
+		this.reports.retryAt(reports, time.Now().Add(delay))
+		vnic.Resources().Logger().Error("[DiscoverNodes] ", this.name, " discovery failed, retry in ", delay.String(), ": ", err.Error())
+	}
+}
+
+func nextDiscoveryDelay(delay time.Duration) time.Duration {
+	return min(delay*2, maxDiscoveryDelay)
+}
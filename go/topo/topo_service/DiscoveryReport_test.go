@@ -0,0 +1,167 @@
+package topo_service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/saichler/l8services/go/services/manager"
+	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+func TestDiscoveryReports(t *testing.T) {
+	reports := newReportsStore()
+	for i := 0; i < 3; i++ {
+		reports.end(reports.start("inventory"), errors.New("no response"))
+	}
+	report := reports.start("inventory")
+	if report.Attempt != 4 {
+		t.Fatal("expected the failed attempts to be counted", report.Attempt)
+	}
+	reports.end(report, nil)
+	if reports.start("inventory").Attempt != 1 {
+		t.Fatal("expected a successful discovery to reset the attempts")
+	}
+	for i := 0; i < maxDiscoveryReports; i++ {
+		reports.end(reports.start("inventory"), nil)
+	}
+	if len(reports.list()) != maxDiscoveryReports {
+		t.Fatal("expected the reports to be bounded", len(reports.list()))
+	}
+
+	delay := firstDiscoveryDelay
+	for i := 0; i < 20; i++ {
+		delay = nextDiscoveryDelay(delay)
+	}
+	if delay != maxDiscoveryDelay {
+		t.Fatal("expected the backoff to be bounded", delay)
+	}
+}
+
+func TestElementsOf(t *testing.T) {
+	if _, err := elementsOf(object.New(nil, nil)); err == nil {
+		t.Fatal("expected an error for a response without elements")
+	}
+	if _, err := elementsOf(object.New(nil, &l8topo.L8TopologyNode{})); err == nil {
+		t.Fatal("expected an error for a response without a list")
+	}
+	list := &l8topo.L8TopologyDiscoveryList{List: []*l8topo.L8TopologyDiscovery{{Name: "a"}, {Name: "b"}}}
+	elems, err := elementsOf(object.New(nil, list))
+	if err != nil || len(elems) != 2 {
+		t.Fatal("expected the list elements", len(elems), err)
+	}
+}
+
+func TestReportsHaveNoRevision(t *testing.T) {
+	service := &TopoService{name: "test", reports: newReportsStore(), revisions: newRevisionStore()}
+	service.reports.end(service.reports.start("inventory"), nil)
+	revision := service.revisions.add(&l8topo.L8Topology{Nodes: map[string]*l8topo.L8TopologyNode{"r1": {NodeId: "r1"}}})
+	resp := service.Get(object.New(nil, &l8topo.L8TopologyQuery{DiscoveryReports: true, Revision: revision}), nil)
+	topology := resp.Element().(*l8topo.L8Topology)
+	if topology.Delta || topology.Revision != "" || len(topology.RemovedNodes) != 0 || len(topology.DiscoveryReports) != 1 {
+		t.Fatal("expected the reports without a revision", topology)
+	}
+	if len(service.revisions.revisions) != 1 {
+		t.Fatal("expected the reports not to be kept as a revision")
+	}
+}
+
+func TestRemoveUnreported(t *testing.T) {
+	service := newCachedService()
+	for _, nodeId := range []string{"r1", "r2", "r3"} {
+		service.doNodes(ifs.POST, &l8topo.L8TopologyNode{NodeId: nodeId})
+	}
+	service.doLinks(ifs.POST, createLink("r1", "r2", l8topo.L8TopologyLinkDirection_Bidirectional))
+	service.doLinks(ifs.POST, createLink("r2", "r3", l8topo.L8TopologyLinkDirection_Bidirectional))
+
+	// r2 was removed from the inventory since the previous discovery, with its links
+	service.removeUnreported([]*l8topo.L8TopologyNode{{NodeId: "r1"}, {NodeId: "r3"}}, nil)
+	nodeIds := make([]string, 0)
+	for _, node := range service.nodes.Collect(func(i interface{}) (bool, interface{}) { return true, i }) {
+		nodeIds = append(nodeIds, node.(*l8topo.L8TopologyNode).NodeId)
+	}
+	if !sameIds(nodeIds, []string{"r1", "r3"}) || service.links.Size() != 0 {
+		t.Fatal("expected the unreported node and links to be removed", nodeIds, service.links.Size())
+	}
+}
+
+// staticTestDiscovery is a static source of nodes, that fails while it has an error
+type staticTestDiscovery struct {
+	testDiscovery
+	nodes   []string
+	err     error
+	fetches int
+}
+
+func (this *staticTestDiscovery) StaticElements() ([]interface{}, error) {
+	this.fetches++
+	if this.err != nil {
+		return nil, this.err
+	}
+	elems := make([]interface{}, 0, len(this.nodes))
+	for _, nodeId := range this.nodes {
+		elems = append(elems, &l8topo.L8TopologyNode{NodeId: nodeId, Name: nodeId})
+	}
+	return elems, nil
+}
+
+// testVNic is a vnic of only resources, for the topology list updates of a discovery
+type testVNic struct {
+	ifs.IVNic
+	resources ifs.IResources
+}
+
+func (this *testVNic) Resources() ifs.IResources {
+	return this.resources
+}
+
+func TestDiscoverSources(t *testing.T) {
+	service := newCachedService()
+	resources := newTestResources()
+	resources.Set(manager.NewServices(resources))
+	service.vnic = &testVNic{resources: resources}
+	service.reports = newReportsStore()
+	service.status = newTopologyStatus("test", "", "test", 0)
+	service.rules = DefaultIdentityRules
+	inventory := &staticTestDiscovery{testDiscovery: testDiscovery{name: "inventory"}, nodes: []string{"r1", "r2"}}
+	legacy := &staticTestDiscovery{testDiscovery: testDiscovery{name: "legacy"}, nodes: []string{"r3"}, err: errors.New("no response")}
+	service.sources = newDiscoverySources([]ITopoDiscovery{inventory, legacy})
+	nodeIds := func() []string {
+		ids := make([]string, 0)
+		for _, node := range service.nodes.Collect(func(i interface{}) (bool, interface{}) { return true, i }) {
+			ids = append(ids, node.(*l8topo.L8TopologyNode).NodeId)
+		}
+		return ids
+	}
+
+	// The failed source is retried alone, the responded source is merged without fetching it again
+	responded := make(map[string][]interface{})
+	reports, err := service.discoverSources(responded, service.vnic)
+	if err == nil || len(reports) != 2 || !sameIds(nodeIds(), []string{"r1", "r2"}) {
+		t.Fatal("expected the nodes of the responded source only", err, len(reports), nodeIds())
+	}
+	legacy.err = nil
+	reports, err = service.discoverSources(responded, service.vnic)
+	if err != nil || len(reports) != 1 || reports[0].Source != "legacy/0" {
+		t.Fatal("expected only the failed source to be retried", err, len(reports))
+	}
+	if inventory.fetches != 1 || legacy.fetches != 2 || !sameIds(nodeIds(), []string{"r1", "r2", "r3"}) {
+		t.Fatal("unexpected fetches or nodes", inventory.fetches, legacy.fetches, nodeIds())
+	}
+
+	// A node that no source reports is removed after a full successful discovery, not after a failed one
+	inventory.nodes = []string{"r1"}
+	legacy.err = errors.New("no response")
+	service.DiscoverNodes(service.vnic)
+	if !sameIds(nodeIds(), []string{"r1", "r2", "r3"}) {
+		t.Fatal("expected a failed discovery to keep the nodes", nodeIds())
+	}
+	legacy.err = nil
+	if _, err = service.DiscoverNodes(service.vnic); err != nil {
+		t.Fatal(err)
+	}
+	if !sameIds(nodeIds(), []string{"r1", "r3"}) {
+		t.Fatal("expected the removed node to be removed", nodeIds())
+	}
+}
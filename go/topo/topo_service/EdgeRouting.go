@@ -0,0 +1,133 @@
+package topo_service
+
+import "github.com/saichler/l8topology/go/types/l8topo"
+
+const (
+	routingNodeBoxSize float32 = 40 // Width and height of the box around a node
+	routingMargin      float32 = 10 // Clearance kept between a route and a node box
+	routingChannelStep float32 = 20 // Distance between alternative channels of a route
+	routingMaxChannels         = 10 // Alternative channels tried on each side of the middle
+)
+
+type routingBox struct {
+	nodeId         string
+	x0, y0, x1, y1 float32
+}
+
+type routingPoint struct {
+	x, y float32
+}
+
+// RouteEdges computes orthogonal polylines for the topology links that avoid the node boxes,
+// and sets them as the bend points of each link
+func RouteEdges(topology *l8topo.L8Topology) {
+	half := routingNodeBoxSize/2 + routingMargin
+	boxes := make([]*routingBox, 0, len(topology.Nodes))
+	for nodeId := range topology.Nodes {
+		location := topology.Locations[nodeId]
+		if location == nil {
+			continue
+		}
+		boxes = append(boxes, &routingBox{
+			nodeId: nodeId,
+			x0:     location.SvgX - half,
+			y0:     location.SvgY - half,
+			x1:     location.SvgX + half,
+			y1:     location.SvgY + half,
+		})
+	}
+
+	for _, link := range topology.Links {
+		aside := topology.Locations[link.Aside]
+		zside := topology.Locations[link.Zside]
+		if aside == nil || zside == nil {
+			continue
+		}
+		a := routingPoint{x: aside.SvgX, y: aside.SvgY}
+		z := routingPoint{x: zside.SvgX, y: zside.SvgY}
+		bends := routeLink(a, z, link.Aside, link.Zside, boxes)
+		link.BendPoints = make([]*l8topo.L8TopologyPoint, 0, len(bends))
+		for _, bend := range bends {
+			link.BendPoints = append(link.BendPoints, &l8topo.L8TopologyPoint{X: bend.x, Y: bend.y})
+		}
+	}
+}
+
+// routeLink returns the bend points of the first clear orthogonal route between a and z,
+// trying a straight line, then L shapes, then Z shapes through channels further and further from the middle.
+// When none of the channels is clear, the route falls back to the middle horizontal channel,
+// which ignores the node boxes and may cross some of them.
+func routeLink(a, z routingPoint, aside, zside string, boxes []*routingBox) []routingPoint {
+	if (a.x == z.x || a.y == z.y) && isRouteClear(a, z, nil, aside, zside, boxes) {
+		return nil
+	}
+
+	candidates := [][]routingPoint{
+		{{x: z.x, y: a.y}},
+		{{x: a.x, y: z.y}},
+	}
+	midX := (a.x + z.x) / 2
+	midY := (a.y + z.y) / 2
+	for i := 0; i <= routingMaxChannels; i++ {
+		for _, sign := range []float32{1, -1} {
+			offset := sign * float32(i) * routingChannelStep
+			candidates = append(candidates,
+				[]routingPoint{{x: a.x, y: midY + offset}, {x: z.x, y: midY + offset}},
+				[]routingPoint{{x: midX + offset, y: a.y}, {x: midX + offset, y: z.y}})
+			if i == 0 {
+				break
+			}
+		}
+	}
+
+	for _, bends := range candidates {
+		bends = dropDuplicateBends(a, z, bends)
+		if isRouteClear(a, z, bends, aside, zside, boxes) {
+			return bends
+		}
+	}
+
+	// No clear route, fall back to the middle horizontal channel even if it crosses a node box
+	return dropDuplicateBends(a, z, []routingPoint{{x: a.x, y: midY}, {x: z.x, y: midY}})
+}
+
+// dropDuplicateBends removes bend points that fall on the previous point of the route or on its end
+func dropDuplicateBends(a, z routingPoint, bends []routingPoint) []routingPoint {
+	result := make([]routingPoint, 0, len(bends))
+	prev := a
+	for _, bend := range bends {
+		if bend == prev || bend == z {
+			continue
+		}
+		result = append(result, bend)
+		prev = bend
+	}
+	return result
+}
+
+func isRouteClear(a, z routingPoint, bends []routingPoint, aside, zside string, boxes []*routingBox) bool {
+	prev := a
+	for _, bend := range bends {
+		if !isSegmentClear(prev, bend, aside, zside, boxes) {
+			return false
+		}
+		prev = bend
+	}
+	return isSegmentClear(prev, z, aside, zside, boxes)
+}
+
+// isSegmentClear returns true if the axis aligned segment does not cross any node box,
+// other than the boxes of the link end nodes
+func isSegmentClear(p, q routingPoint, aside, zside string, boxes []*routingBox) bool {
+	minX, maxX := min(p.x, q.x), max(p.x, q.x)
+	minY, maxY := min(p.y, q.y), max(p.y, q.y)
+	for _, box := range boxes {
+		if box.nodeId == aside || box.nodeId == zside {
+			continue
+		}
+		if maxX >= box.x0 && minX <= box.x1 && maxY >= box.y0 && minY <= box.y1 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package topo_service
+
+import (
+	"strconv"
+	"testing"
+)
+
+// wallOf returns the nodes of a vertical wall at x, from y0 to y1, with no gap between their boxes
+func wallOf(x, y0, y1 float32) []routingPoint {
+	wall := make([]routingPoint, 0)
+	for y := y0; y <= y1; y += routingNodeBoxSize {
+		wall = append(wall, routingPoint{x: x, y: y})
+	}
+	return wall
+}
+
+func TestRouteEdges(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, z      routingPoint
+		obstacles []routingPoint
+		bends     int
+		clear     bool
+	}{
+		{name: "straight", a: routingPoint{x: 100, y: 100}, z: routingPoint{x: 500, y: 100}, clear: true},
+		{name: "l shape", a: routingPoint{x: 100, y: 100}, z: routingPoint{x: 500, y: 400}, bends: 1, clear: true},
+		{name: "around a node", a: routingPoint{x: 100, y: 100}, z: routingPoint{x: 500, y: 100},
+			obstacles: []routingPoint{{x: 300, y: 100}}, bends: 2, clear: true},
+		// Both corners of the L shapes are taken, the route is a Z shape
+		{name: "z shape", a: routingPoint{x: 100, y: 100}, z: routingPoint{x: 500, y: 400},
+			obstacles: []routingPoint{{x: 500, y: 100}, {x: 100, y: 400}}, bends: 2, clear: true},
+		// A wall of nodes between the ends leaves no clear channel
+		{name: "fallback", a: routingPoint{x: 100, y: 100}, z: routingPoint{x: 500, y: 400},
+			obstacles: wallOf(300, -200, 700), bends: 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			topology := newTestTopology()
+			addTestNode(topology, "a", "a", test.a.x, test.a.y)
+			addTestNode(topology, "z", "z", test.z.x, test.z.y)
+			for i, obstacle := range test.obstacles {
+				nodeId := "o" + strconv.Itoa(i)
+				addTestNode(topology, nodeId, nodeId, obstacle.x, obstacle.y)
+			}
+			link := addTestLink(topology, "a", "z")
+			RouteEdges(topology)
+			if len(link.BendPoints) != test.bends {
+				t.Fatal("expected", test.bends, "bends, got", link.BendPoints)
+			}
+			route := []routingPoint{test.a}
+			for _, bend := range link.BendPoints {
+				route = append(route, routingPoint{x: bend.X, y: bend.Y})
+			}
+			route = append(route, test.z)
+			half := routingNodeBoxSize/2 + routingMargin
+			boxes := make([]*routingBox, 0, len(test.obstacles))
+			for _, obstacle := range test.obstacles {
+				boxes = append(boxes, &routingBox{x0: obstacle.x - half, y0: obstacle.y - half, x1: obstacle.x + half, y1: obstacle.y + half})
+			}
+			for i := 1; i < len(route); i++ {
+				if route[i-1].x != route[i].x && route[i-1].y != route[i].y {
+					t.Fatal("expected orthogonal segments, got", route)
+				}
+			}
+			if isRouteClear(test.a, test.z, route[1:len(route)-1], "a", "z", boxes) != test.clear {
+				t.Fatal("expected the route clear to be", test.clear, route)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package topo_service
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+// topoFilter holds the node and link filters of a topology query, an empty filter accepts everything
+type topoFilter struct {
+	nodeIds      map[string]bool
+	nodeTypes    map[l8topo.L8TopologyNodeType]bool
+	name         *regexp.Regexp
+	location     *regexp.Regexp
+	linkStatuses map[l8topo.L8TopologyLinkStatus]bool
+	attributes   map[string]*regexp.Regexp
+	linkAttrs    map[string]*regexp.Regexp
+	// links under this utilization percentage are filtered out
+	minUtilization float32
+}
+
+func newTopoFilter(tq *l8topo.L8TopologyQuery) (*topoFilter, error) {
+	filter := &topoFilter{}
+	if len(tq.NodeTypes) > 0 {
+		filter.nodeTypes = make(map[l8topo.L8TopologyNodeType]bool)
+		for _, nodeType := range tq.NodeTypes {
+			filter.nodeTypes[nodeType] = true
+		}
+	}
+	if tq.NamePattern != "" {
+		name, err := regexp.Compile(tq.NamePattern)
+		if err != nil {
+			return nil, errors.New("invalid name pattern: " + err.Error())
+		}
+		filter.name = name
+	}
+	if tq.LocationPattern != "" {
+		location, err := regexp.Compile(tq.LocationPattern)
+		if err != nil {
+			return nil, errors.New("invalid location pattern: " + err.Error())
+		}
+		filter.location = location
+	}
+	if len(tq.LinkStatuses) > 0 {
+		filter.linkStatuses = make(map[l8topo.L8TopologyLinkStatus]bool)
+		for _, status := range tq.LinkStatuses {
+			filter.linkStatuses[status] = true
+		}
+	}
+	filter.minUtilization = tq.UtilizationThreshold
+	var err error
+	filter.attributes, err = compileAttributePatterns(tq.AttributePatterns)
+	if err != nil {
+		return nil, err
+	}
+	filter.linkAttrs, err = compileAttributePatterns(tq.LinkAttributePatterns)
+	if err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func (this *topoFilter) acceptNode(node *l8topo.L8TopologyNode) bool {
+	if this.nodeIds != nil && !this.nodeIds[node.NodeId] {
+		return false
+	}
+	if this.nodeTypes != nil && !this.nodeTypes[node.Type] {
+		return false
+	}
+	if this.name != nil && !this.name.MatchString(node.Name) {
+		return false
+	}
+	if this.location != nil && !this.location.MatchString(node.Location) {
+		return false
+	}
+	return matchAttributes(this.attributes, node.Attributes)
+}
+
+func (this *topoFilter) acceptLink(link *l8topo.L8TopologyLink) bool {
+	if !matchAttributes(this.linkAttrs, link.Attributes) {
+		return false
+	}
+	if this.minUtilization > 0 && link.Utilization < this.minUtilization {
+		return false
+	}
+	if this.linkStatuses == nil {
+		return true
+	}
+	// A link without a known status is considered up
+	status := link.Status
+	if status == l8topo.L8TopologyLinkStatus_InvalidStatus {
+		status = l8topo.L8TopologyLinkStatus_Up
+	}
+	return this.linkStatuses[status]
+}
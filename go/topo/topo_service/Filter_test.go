@@ -0,0 +1,106 @@
+package topo_service
+
+import (
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+func TestFilterNodes(t *testing.T) {
+	nodes := []*l8topo.L8TopologyNode{
+		{NodeId: "r1", Name: "core-paris", Location: "Paris, France", Type: l8topo.L8TopologyNodeType_ROUTER,
+			Attributes: map[string]string{"role": "core"}},
+		{NodeId: "s1", Name: "access-paris", Location: "Paris, France", Type: l8topo.L8TopologyNodeType_SWITCH},
+		{NodeId: "r2", Name: "core-london", Location: "London, United Kingdom", Type: l8topo.L8TopologyNodeType_ROUTER,
+			Attributes: map[string]string{"role": "edge"}},
+	}
+	tests := []struct {
+		name     string
+		query    *l8topo.L8TopologyQuery
+		accepted []string
+	}{
+		{name: "no filter", query: &l8topo.L8TopologyQuery{}, accepted: []string{"r1", "s1", "r2"}},
+		{name: "type", query: &l8topo.L8TopologyQuery{NodeTypes: []l8topo.L8TopologyNodeType{l8topo.L8TopologyNodeType_ROUTER}},
+			accepted: []string{"r1", "r2"}},
+		{name: "name", query: &l8topo.L8TopologyQuery{NamePattern: "^core-"}, accepted: []string{"r1", "r2"}},
+		{name: "location", query: &l8topo.L8TopologyQuery{LocationPattern: "France$"}, accepted: []string{"r1", "s1"}},
+		{name: "attribute", query: &l8topo.L8TopologyQuery{AttributePatterns: map[string]string{"role": "^core$"}},
+			accepted: []string{"r1"}},
+		// A node without the attribute is matched as an empty value
+		{name: "missing attribute", query: &l8topo.L8TopologyQuery{AttributePatterns: map[string]string{"role": "^$"}},
+			accepted: []string{"s1"}},
+		{name: "combined", query: &l8topo.L8TopologyQuery{NamePattern: "paris", NodeTypes: []l8topo.L8TopologyNodeType{l8topo.L8TopologyNodeType_SWITCH}},
+			accepted: []string{"s1"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := newTopoFilter(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			accepted := make([]string, 0)
+			for _, node := range nodes {
+				if filter.acceptNode(node) {
+					accepted = append(accepted, node.NodeId)
+				}
+			}
+			if !sameIds(accepted, test.accepted) {
+				t.Fatal("expected", test.accepted, "got", accepted)
+			}
+		})
+	}
+}
+
+func TestFilterLinks(t *testing.T) {
+	links := []*l8topo.L8TopologyLink{
+		{LinkId: "up", Status: l8topo.L8TopologyLinkStatus_Up, Utilization: 80},
+		{LinkId: "down", Status: l8topo.L8TopologyLinkStatus_Down, Attributes: map[string]string{"media": "fiber"}},
+		{LinkId: "unknown", Utilization: 20},
+	}
+	tests := []struct {
+		name     string
+		query    *l8topo.L8TopologyQuery
+		accepted []string
+	}{
+		{name: "no filter", query: &l8topo.L8TopologyQuery{}, accepted: []string{"up", "down", "unknown"}},
+		// A link without a known status is up
+		{name: "up", query: &l8topo.L8TopologyQuery{LinkStatuses: []l8topo.L8TopologyLinkStatus{l8topo.L8TopologyLinkStatus_Up}},
+			accepted: []string{"up", "unknown"}},
+		{name: "down", query: &l8topo.L8TopologyQuery{LinkStatuses: []l8topo.L8TopologyLinkStatus{l8topo.L8TopologyLinkStatus_Down}},
+			accepted: []string{"down"}},
+		{name: "utilization", query: &l8topo.L8TopologyQuery{UtilizationThreshold: 50}, accepted: []string{"up"}},
+		{name: "attribute", query: &l8topo.L8TopologyQuery{LinkAttributePatterns: map[string]string{"media": "fiber"}},
+			accepted: []string{"down"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := newTopoFilter(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			accepted := make([]string, 0)
+			for _, link := range links {
+				if filter.acceptLink(link) {
+					accepted = append(accepted, link.LinkId)
+				}
+			}
+			if !sameIds(accepted, test.accepted) {
+				t.Fatal("expected", test.accepted, "got", accepted)
+			}
+		})
+	}
+}
+
+func TestFilterInvalidPatterns(t *testing.T) {
+	queries := []*l8topo.L8TopologyQuery{
+		{NamePattern: "("},
+		{LocationPattern: "["},
+		{AttributePatterns: map[string]string{"role": "("}},
+		{LinkAttributePatterns: map[string]string{"media": "("}},
+	}
+	for _, query := range queries {
+		if _, err := newTopoFilter(query); err == nil {
+			t.Fatal("expected an invalid pattern to fail", query)
+		}
+	}
+}
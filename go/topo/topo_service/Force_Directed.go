@@ -8,22 +8,24 @@ import (
 )
 
 const (
-	forceIterations     = 300
-	forceRepulsion      = 5000.0  // Repulsion constant between nodes
-	forceAttraction     = 0.01   // Spring constant for links
-	forceDamping        = 0.85   // Velocity damping factor
-	forceMinMovement    = 0.5    // Stop if max movement is below this
-	forcePadding        = 80.0
-	forceIdealLength    = 100.0  // Ideal spring length
+	forceIterations  = 300
+	forceRepulsion   = 5000.0 // Repulsion constant between nodes
+	forceAttraction  = 0.01   // Spring constant for links
+	forceDamping     = 0.85   // Velocity damping factor
+	forceMinMovement = 0.5    // Stop if max movement is below this
+	forcePadding     = 80.0
+	forceIdealLength = 100.0 // Ideal spring length
+	forceSeed        = 1
 )
 
 type forceNode struct {
 	x, y   float64
 	vx, vy float64
 	nodeId string
+	pinned bool
 }
 
-func Force_Directed(topology *l8topo.L8Topology) {
+func Force_Directed(topology *l8topo.L8Topology, pinned map[string]*l8topo.L8TopologyPinnedPosition) {
 	nodes := topology.GetNodes()
 	links := topology.GetLinks()
 
@@ -32,10 +34,13 @@ func Force_Directed(topology *l8topo.L8Topology) {
 		return
 	}
 
-	centerX := float64(svgWidth) / 2
-	centerY := float64(svgHeight) / 2
-	maxX := float64(svgWidth) - forcePadding
-	maxY := float64(svgHeight) - forcePadding
+	// Grow the canvas so every node has about an ideal spring length square of its own
+	growCanvasToFit(topology, float32(math.Sqrt(float64(nodeCount))*forceIdealLength+2*forcePadding))
+
+	centerX := float64(topology.Width) / 2
+	centerY := float64(topology.Height) / 2
+	maxX := float64(topology.Width) - forcePadding
+	maxY := float64(topology.Height) - forcePadding
 
 	// Build adjacency list
 	adjacency := make(map[string]map[string]bool)
@@ -56,23 +61,33 @@ func Force_Directed(topology *l8topo.L8Topology) {
 	forceNodes := make(map[string]*forceNode)
 	nodeList := make([]*forceNode, 0, nodeCount)
 
+	// A fixed seed places the same topology the same way on every query
+	random := rand.New(rand.NewSource(forceSeed))
 	i := 0
-	for _, node := range nodes {
+	for _, node := range sortedNodes(nodes) {
 		// Start with a circular distribution plus some randomness
 		angle := 2 * math.Pi * float64(i) / float64(nodeCount)
-		radius := 100.0 + rand.Float64()*100.0
+		radius := 100.0 + random.Float64()*100.0
 		fn := &forceNode{
-			x:      centerX + radius*math.Cos(angle) + (rand.Float64()-0.5)*50,
-			y:      centerY + radius*math.Sin(angle) + (rand.Float64()-0.5)*50,
+			x:      centerX + radius*math.Cos(angle) + (random.Float64()-0.5)*50,
+			y:      centerY + radius*math.Sin(angle) + (random.Float64()-0.5)*50,
 			vx:     0,
 			vy:     0,
 			nodeId: node.NodeId,
 		}
+		// Pinned nodes start, and stay, at their pinned position
+		if pin, ok := pinned[node.NodeId]; ok {
+			fn.x = float64(pin.SvgX)
+			fn.y = float64(pin.SvgY)
+			fn.pinned = true
+		}
 		forceNodes[node.NodeId] = fn
 		nodeList = append(nodeList, fn)
 		i++
 	}
 
+	linkList := sortedLinks(links)
+
 	// Run force simulation
 	for iter := 0; iter < forceIterations; iter++ {
 		maxMovement := 0.0
@@ -105,7 +120,7 @@ func Force_Directed(topology *l8topo.L8Topology) {
 		}
 
 		// Calculate attractive forces for connected nodes (springs)
-		for _, link := range links {
+		for _, link := range linkList {
 			n1 := forceNodes[link.Aside]
 			n2 := forceNodes[link.Zside]
 			if n1 == nil || n2 == nil {
@@ -135,6 +150,12 @@ func Force_Directed(topology *l8topo.L8Topology) {
 
 		// Apply velocities and damping
 		for _, fn := range nodeList {
+			if fn.pinned {
+				fn.vx = 0
+				fn.vy = 0
+				continue
+			}
+
 			// Apply damping
 			fn.vx *= forceDamping
 			fn.vy *= forceDamping
@@ -174,7 +195,15 @@ func Force_Directed(topology *l8topo.L8Topology) {
 		}
 	}
 
-	// Center the graph
+	// Center the graph, unless it is anchored by pinned nodes
+	hasPinned := false
+	for _, fn := range nodeList {
+		if fn.pinned {
+			hasPinned = true
+			break
+		}
+	}
+
 	minX, minY := math.MaxFloat64, math.MaxFloat64
 	graphMaxX, graphMaxY := -math.MaxFloat64, -math.MaxFloat64
 	for _, fn := range nodeList {
@@ -196,9 +225,16 @@ func Force_Directed(topology *l8topo.L8Topology) {
 	graphHeight := graphMaxY - minY
 	offsetX := centerX - (minX + graphWidth/2)
 	offsetY := centerY - (minY + graphHeight/2)
+	if hasPinned {
+		offsetX = 0
+		offsetY = 0
+	}
 
 	// Apply centering offset
 	for _, fn := range nodeList {
+		if fn.pinned {
+			continue
+		}
 		fn.x += offsetX
 		fn.y += offsetY
 
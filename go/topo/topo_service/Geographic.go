@@ -0,0 +1,225 @@
+package topo_service
+
+import (
+	"math"
+	"sort"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+const (
+	geoClusterMaxZoom   float32 = 2  // Nearby locations are clustered below this zoom
+	geoClusterDistance  float32 = 60 // On screen distance under which locations are clustered
+	geoMarkerSpacing    float32 = 24 // On screen distance kept between location markers
+	geoSpreadIterations         = 50
+	geoClusterPrefix            = "cluster:"
+)
+
+type geoCell struct {
+	x, y int
+}
+
+// Geographic clusters nearby locations into regional bubbles at low zoom,
+// and pushes the remaining overlapping location markers apart.
+// Each location keeps its projected SvgX/SvgY as the anchor of its leader line,
+// while DisplayX/DisplayY is where its marker is drawn.
+func Geographic(topology *l8topo.L8Topology, zoom float32) {
+	if zoom <= 0 {
+		zoom = 1
+	}
+	if zoom < geoClusterMaxZoom {
+		clusterLocations(topology, geoClusterDistance/zoom)
+	}
+	spreadLocations(topology, geoMarkerSpacing/zoom)
+}
+
+// zoomOf returns the query zoom, or the zoom implied by its bounding box on a map of the given width
+func zoomOf(tq *l8topo.L8TopologyQuery, mapWidth float32) float32 {
+	if tq.Zoom > 0 {
+		return tq.Zoom
+	}
+	if tq.X1 > tq.X {
+		return mapWidth / (tq.X1 - tq.X)
+	}
+	return 1
+}
+
+func clusterLocations(topology *l8topo.L8Topology, distance float32) {
+	// Biggest locations seed the clusters
+	list := make([]*l8topo.L8TopologyNode, 0, len(topology.Nodes))
+	for _, node := range topology.Nodes {
+		list = append(list, node)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Count != list[j].Count {
+			return list[i].Count > list[j].Count
+		}
+		return list[i].NodeId < list[j].NodeId
+	})
+
+	clusterOf := make(map[string]string)
+	for i, seed := range list {
+		if _, ok := clusterOf[seed.NodeId]; ok {
+			continue
+		}
+		seedLocation := topology.Locations[seed.Location]
+		if seedLocation == nil {
+			continue
+		}
+		members := []*l8topo.L8TopologyNode{seed}
+		for _, other := range list[i+1:] {
+			if _, ok := clusterOf[other.NodeId]; ok {
+				continue
+			}
+			otherLocation := topology.Locations[other.Location]
+			if otherLocation == nil {
+				continue
+			}
+			if distanceOf(seedLocation.SvgX, seedLocation.SvgY, otherLocation.SvgX, otherLocation.SvgY) <= distance {
+				members = append(members, other)
+			}
+		}
+		if len(members) == 1 {
+			continue
+		}
+
+		cluster := &l8topo.L8TopologyNode{}
+		cluster.NodeId = geoClusterPrefix + seed.NodeId
+		cluster.Name = seed.Name
+		cluster.Location = cluster.NodeId
+		cluster.Type = l8topo.L8TopologyNodeType_NETWORK_AGGREGATION
+		clusterLocation := &l8topo.L8TopologyLocation{Location: cluster.NodeId}
+
+		// The cluster is placed at the center of its members, weighted by their count
+		var weights float32
+		for _, member := range members {
+			location := topology.Locations[member.Location]
+			weight := float32(max(member.Count, 1))
+			weights += weight
+			clusterLocation.SvgX += location.SvgX * weight
+			clusterLocation.SvgY += location.SvgY * weight
+			clusterLocation.Latitude += location.Latitude * weight
+			clusterLocation.Longitude += location.Longitude * weight
+			cluster.Count += member.Count
+			cluster.Status = aggregateNodeStatus(cluster.Status, member.Status)
+			cluster.MemberIds = append(cluster.MemberIds, member.NodeId)
+			if len(cluster.MemberIds) == 1 {
+				cluster.Attributes = copyAttributes(member.Attributes)
+			} else {
+				cluster.Attributes = commonAttributes(cluster.Attributes, member.Attributes)
+			}
+			clusterOf[member.NodeId] = cluster.NodeId
+			delete(topology.Nodes, member.NodeId)
+			delete(topology.Locations, member.Location)
+		}
+		clusterLocation.SvgX /= weights
+		clusterLocation.SvgY /= weights
+		clusterLocation.Latitude /= weights
+		clusterLocation.Longitude /= weights
+		topology.Nodes[cluster.NodeId] = cluster
+		topology.Locations[clusterLocation.Location] = clusterLocation
+	}
+
+	if len(clusterOf) == 0 {
+		return
+	}
+
+	// Move the links to the clusters, dropping the links inside a cluster
+	links := make(map[string]*l8topo.L8TopologyLink)
+	for _, link := range sortedLinks(topology.Links) {
+		aside := link.Aside
+		if clusterId, ok := clusterOf[aside]; ok {
+			aside = clusterId
+		}
+		zside := link.Zside
+		if clusterId, ok := clusterOf[zside]; ok {
+			zside = clusterId
+		}
+		if aside == zside {
+			continue
+		}
+		linkId := aside + zside
+		exist, ok := links[linkId]
+		if ok {
+			mergeLinks(exist, link)
+			continue
+		}
+		link.LinkId = linkId
+		link.Aside = aside
+		link.Zside = zside
+		setEndpointNodes(link, aside, zside)
+		links[linkId] = link
+	}
+	topology.Links = links
+}
+
+// spreadLocations pushes apart location markers that are closer than spacing,
+// using a grid of spacing sized cells so only neighbor markers are compared
+func spreadLocations(topology *l8topo.L8Topology, spacing float32) {
+	keys := make([]string, 0, len(topology.Locations))
+	for key, location := range topology.Locations {
+		location.DisplayX = location.SvgX
+		location.DisplayY = location.SvgY
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	list := make([]*l8topo.L8TopologyLocation, len(keys))
+	for i, key := range keys {
+		list[i] = topology.Locations[key]
+	}
+
+	cellOf := func(location *l8topo.L8TopologyLocation) geoCell {
+		return geoCell{x: int(math.Floor(float64(location.DisplayX / spacing))), y: int(math.Floor(float64(location.DisplayY / spacing)))}
+	}
+
+	for iter := 0; iter < geoSpreadIterations; iter++ {
+		grid := make(map[geoCell][]int)
+		for i, location := range list {
+			cell := cellOf(location)
+			grid[cell] = append(grid[cell], i)
+		}
+
+		moved := false
+		for i, a := range list {
+			cell := cellOf(a)
+			for cx := cell.x - 1; cx <= cell.x+1; cx++ {
+				for cy := cell.y - 1; cy <= cell.y+1; cy++ {
+					for _, j := range grid[geoCell{x: cx, y: cy}] {
+						if j <= i {
+							continue
+						}
+						b := list[j]
+						dx := b.DisplayX - a.DisplayX
+						dy := b.DisplayY - a.DisplayY
+						dist := distanceOf(a.DisplayX, a.DisplayY, b.DisplayX, b.DisplayY)
+						if dist >= spacing {
+							continue
+						}
+						if dist < 0.01 {
+							// Same spot, push apart in a direction unique to the pair
+							angle := 2 * math.Pi * float64(j) / float64(len(list))
+							dx = float32(math.Cos(angle))
+							dy = float32(math.Sin(angle))
+							dist = 1
+						}
+						push := (spacing - dist) / 2
+						a.DisplayX -= dx / dist * push
+						a.DisplayY -= dy / dist * push
+						b.DisplayX += dx / dist * push
+						b.DisplayY += dy / dist * push
+						moved = true
+					}
+				}
+			}
+		}
+		if !moved {
+			break
+		}
+	}
+}
+
+func distanceOf(x1, y1, x2, y2 float32) float32 {
+	dx := float64(x2 - x1)
+	dy := float64(y2 - y1)
+	return float32(math.Sqrt(dx*dx + dy*dy))
+}
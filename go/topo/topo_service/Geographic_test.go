@@ -0,0 +1,74 @@
+package topo_service
+
+import (
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+// geoTopology has two locations 10 apart, one far from them, and a link between the near and the far locations
+func geoTopology() *l8topo.L8Topology {
+	topology := newTestTopology()
+	addTestNode(topology, "paris", "paris", 1000, 300).Count = 2
+	addTestNode(topology, "orleans", "orleans", 1000, 310).Count = 1
+	addTestNode(topology, "tokyo", "tokyo", 1800, 350).Count = 1
+	addTestLink(topology, "paris", "tokyo")
+	addTestLink(topology, "orleans", "paris")
+	return topology
+}
+
+func TestGeographic(t *testing.T) {
+	tests := []struct {
+		name    string
+		zoom    float32
+		nodes   []string
+		links   int
+		cluster bool
+	}{
+		{name: "world", zoom: 1, nodes: []string{geoClusterPrefix + "paris", "tokyo"}, links: 1, cluster: true},
+		{name: "zoomed in", zoom: 4, nodes: []string{"paris", "orleans", "tokyo"}, links: 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			topology := geoTopology()
+			Geographic(topology, test.zoom)
+			if !hasKeys(topology.Nodes, test.nodes) || len(topology.Links) != test.links {
+				t.Fatal("unexpected view", topology.Nodes, topology.Links)
+			}
+			if test.cluster {
+				cluster := topology.Nodes[geoClusterPrefix+"paris"]
+				location := topology.Locations[cluster.Location]
+				// The cluster is at the center of its members, weighted by their count
+				if cluster.Count != 3 || len(cluster.MemberIds) != 2 || location.SvgY < 303.3 || location.SvgY > 303.4 {
+					t.Fatal("unexpected cluster", cluster.Count, cluster.MemberIds, location.SvgY)
+				}
+				return
+			}
+			// The markers are spread apart and keep their projected anchors
+			paris := topology.Locations["paris"]
+			orleans := topology.Locations["orleans"]
+			if paris.SvgY != 300 || orleans.SvgY != 310 {
+				t.Fatal("expected the anchors to be kept", paris.SvgY, orleans.SvgY)
+			}
+			if distanceOf(paris.DisplayX, paris.DisplayY, orleans.DisplayX, orleans.DisplayY) < geoMarkerSpacing/test.zoom*0.99 {
+				t.Fatal("expected the markers to be spread", paris.DisplayY, orleans.DisplayY)
+			}
+		})
+	}
+}
+
+func TestSpreadLocations(t *testing.T) {
+	topology := newTestTopology()
+	// Markers on the same spot
+	for _, key := range []string{"a", "b", "c", "d"} {
+		topology.Locations[key] = &l8topo.L8TopologyLocation{Location: key, SvgX: 500, SvgY: 500}
+	}
+	spreadLocations(topology, geoMarkerSpacing)
+	for keyA, a := range topology.Locations {
+		for keyB, b := range topology.Locations {
+			if keyA != keyB && distanceOf(a.DisplayX, a.DisplayY, b.DisplayX, b.DisplayY) < geoMarkerSpacing*0.9 {
+				t.Fatal("expected", keyA, "and", keyB, "to be spread apart")
+			}
+		}
+	}
+}
@@ -1,16 +1,18 @@
 package topo_service
 
-import "github.com/saichler/l8topology/go/types/l8topo"
+import (
+	"sort"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
 
 const (
-	hierarchicalPadding              = 50
-	hierarchicalNodeSpacingX         = 150
-	hierarchicalNodeSpacingY         = 100
-	svgWidth                 float32 = 2000
-	svgHeight                float32 = 857
+	hierarchicalPadding      = 50
+	hierarchicalNodeSpacingX = 150
+	hierarchicalNodeSpacingY = 100
 )
 
-func Hierarchical(topology *l8topo.L8Topology) {
+func Hierarchical(topology *l8topo.L8Topology, pinned map[string]*l8topo.L8TopologyPinnedPosition) {
 	nodes := topology.GetNodes()
 	links := topology.GetLinks()
 
@@ -36,7 +38,7 @@ func Hierarchical(topology *l8topo.L8Topology) {
 	// Find node with most connections as root
 	var rootNode *l8topo.L8TopologyNode
 	maxConnections := 0
-	for _, node := range nodes {
+	for _, node := range sortedNodes(nodes) {
 		connCount := len(adjacency[node.NodeId])
 		if connCount > maxConnections || rootNode == nil {
 			maxConnections = connCount
@@ -78,27 +80,59 @@ func Hierarchical(topology *l8topo.L8Topology) {
 		}
 	}
 
-	// Group nodes by level
+	// Calculate positions and update locations
+	nodePositions := make(map[string]struct{ x, y float32 })
+
+	// Pinned nodes keep their position
+	for nodeId, pin := range pinned {
+		if _, ok := nodes[nodeId]; ok {
+			nodePositions[nodeId] = struct{ x, y float32 }{x: pin.SvgX, y: pin.SvgY}
+		}
+	}
+
+	// Group the unpinned nodes by level
 	levelGroups := make(map[int][]string)
 	for nodeId, level := range levels {
+		if _, ok := nodePositions[nodeId]; ok {
+			continue
+		}
 		levelGroups[level] = append(levelGroups[level], nodeId)
 	}
+	for _, nodesAtLevel := range levelGroups {
+		sort.Strings(nodesAtLevel)
+	}
 
-	// Calculate positions and update locations
-	nodePositions := make(map[string]struct{ x, y float32 })
+	// Grow the canvas so the deepest and the widest levels fit
+	maxLevel := 0
+	widestLevel := 0
+	for level, nodesAtLevel := range levelGroups {
+		if level > maxLevel {
+			maxLevel = level
+		}
+		if len(nodesAtLevel) > widestLevel {
+			widestLevel = len(nodesAtLevel)
+		}
+	}
+	growCanvas(topology,
+		float32(widestLevel-1)*float32(hierarchicalNodeSpacingX)+2*float32(hierarchicalPadding),
+		float32(maxLevel)*float32(hierarchicalNodeSpacingY)+2*float32(hierarchicalPadding))
 
 	for level, nodesAtLevel := range levelGroups {
 		y := float32(hierarchicalPadding) + float32(level)*float32(hierarchicalNodeSpacingY)
-		if y > svgHeight-float32(hierarchicalPadding) {
-			y = svgHeight - float32(hierarchicalPadding)
-		}
 
 		levelWidth := float32(len(nodesAtLevel)-1) * float32(hierarchicalNodeSpacingX)
-		startX := (svgWidth - levelWidth) / 2
-
-		for index, nodeId := range nodesAtLevel {
-			x := startX + float32(index)*float32(hierarchicalNodeSpacingX)
+		startX := (topology.Width - levelWidth) / 2
+
+		// Skip slots in the level that are occupied by pinned nodes
+		slot := 0
+		for _, nodeId := range nodesAtLevel {
+			x := startX + float32(slot)*float32(hierarchicalNodeSpacingX)
+			for isNearPinned(x, y, float32(hierarchicalNodeSpacingX)/2, nodes, pinned) {
+				slot++
+				x = startX + float32(slot)*float32(hierarchicalNodeSpacingX)
+			}
 			nodePositions[nodeId] = struct{ x, y float32 }{x: x, y: y}
+			slot++
 		}
 	}
 
@@ -0,0 +1,107 @@
+package topo_service
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+// IndoorSeparator separates the indoor parts of a location path under its geographic location,
+// e.g. "London, United Kingdom / LON-DC2 / Floor 1 / Room 101 / Rack 7"
+const IndoorSeparator = " / "
+
+// maxIndoorDepth bounds the walk up the parents of a location, in case of a parent cycle
+const maxIndoorDepth = 16
+
+// indoorKinds are the kinds of the parts of a location path, by depth
+var indoorKinds = []l8topo.L8TopologyLocationKind{
+	l8topo.L8TopologyLocationKind_GeoLocation,
+	l8topo.L8TopologyLocationKind_BuildingLocation,
+	l8topo.L8TopologyLocationKind_FloorLocation,
+	l8topo.L8TopologyLocationKind_RoomLocation,
+	l8topo.L8TopologyLocationKind_RackLocation,
+}
+
+// GeoLocationOf returns the geographic location of a location path, the top level placed by the map projection
+func GeoLocationOf(location string) string {
+	geo, _, _ := strings.Cut(location, IndoorSeparator)
+	return geo
+}
+
+// siteOf returns the building of a location path, or the location if it is not inside a building
+func siteOf(location string) string {
+	parts := strings.SplitN(location, IndoorSeparator, 3)
+	if len(parts) < 3 {
+		return location
+	}
+	return parts[0] + IndoorSeparator + parts[1]
+}
+
+// indoorLocationsOf returns the building, floor, room and rack locations of a location path,
+// each is the child of the path before it
+func indoorLocationsOf(location string) []*l8topo.L8TopologyLocation {
+	parts := strings.Split(location, IndoorSeparator)
+	result := make([]*l8topo.L8TopologyLocation, 0, len(parts)-1)
+	for i := 1; i < len(parts); i++ {
+		indoor := &l8topo.L8TopologyLocation{}
+		indoor.Location = strings.Join(parts[:i+1], IndoorSeparator)
+		indoor.Parent = strings.Join(parts[:i], IndoorSeparator)
+		indoor.Kind = indoorKinds[min(i, len(indoorKinds)-1)]
+		result = append(result, indoor)
+	}
+	return result
+}
+
+// parentOf returns the parent of a location, from its cached location or else from its path
+func (this *TopoService) parentOf(location string) (string, *l8topo.L8TopologyLocation) {
+	cached := this.nodeL8Location(location)
+	if cached != nil && cached.Parent != "" {
+		return cached.Parent, cached
+	}
+	index := strings.LastIndex(location, IndoorSeparator)
+	if index == -1 {
+		return "", cached
+	}
+	return location[:index], cached
+}
+
+// floorPlanOf returns the location of the floor plan of a Floor_Plan query, a building, a floor or a room
+func (this *TopoService) floorPlanOf(tq *l8topo.L8TopologyQuery) (*l8topo.L8TopologyLocation, error) {
+	if tq.FloorPlan == "" {
+		return nil, errors.New("floor plan layout requires a floor plan location")
+	}
+	plan := this.nodeL8Location(tq.FloorPlan)
+	if plan == nil {
+		return nil, errors.New("unknown floor plan location " + tq.FloorPlan)
+	}
+	return plan, nil
+}
+
+// planLocationOf returns the view location of a location on the floor plan,
+// at the sum of the local coordinates of the locations from the plan down to the location,
+// or nil if the location is not on the plan
+func (this *TopoService) planLocationOf(location, plan string) *l8topo.L8TopologyLocation {
+	planLocation := &l8topo.L8TopologyLocation{Location: location}
+	for depth := 0; location != plan; depth++ {
+		if location == "" || depth == maxIndoorDepth {
+			return nil
+		}
+		parent, cached := this.parentOf(location)
+		if cached != nil {
+			planLocation.SvgX += cached.LocalX
+			planLocation.SvgY += cached.LocalY
+		}
+		location = parent
+	}
+	return planLocation
+}
+
+// setFloorPlanCanvas sets the topology canvas to the floor plan image, or to the world map size if it has no size
+func setFloorPlanCanvas(topology *l8topo.L8Topology, plan *l8topo.L8TopologyLocation) {
+	topology.FloorPlanImage = plan.FloorPlanImage
+	topology.Width, topology.Height = plan.FloorPlanWidth, plan.FloorPlanHeight
+	if topology.Width <= 0 || topology.Height <= 0 {
+		topology.Width, topology.Height = svgWidth, svgHeight
+	}
+}
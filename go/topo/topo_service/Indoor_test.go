@@ -0,0 +1,41 @@
+package topo_service
+
+import (
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+func TestIndoorLocations(t *testing.T) {
+	path := "London, United Kingdom / LON-DC2 / Floor 1 / Room 101 / Rack 7"
+	if geo := GeoLocationOf(path); geo != "London, United Kingdom" {
+		t.Fatal("unexpected geographic location", geo)
+	}
+	if site := siteOf(path); site != "London, United Kingdom / LON-DC2" {
+		t.Fatal("unexpected site", site)
+	}
+	if site := siteOf("London, United Kingdom"); site != "London, United Kingdom" {
+		t.Fatal("a geographic location should be its own site, got", site)
+	}
+	indoor := indoorLocationsOf(path)
+	kinds := []l8topo.L8TopologyLocationKind{
+		l8topo.L8TopologyLocationKind_BuildingLocation,
+		l8topo.L8TopologyLocationKind_FloorLocation,
+		l8topo.L8TopologyLocationKind_RoomLocation,
+		l8topo.L8TopologyLocationKind_RackLocation,
+	}
+	if len(indoor) != len(kinds) {
+		t.Fatal("expected", len(kinds), "indoor locations, got", len(indoor))
+	}
+	for i, location := range indoor {
+		if location.Kind != kinds[i] {
+			t.Fatal(location.Location, "expected", kinds[i], "got", location.Kind)
+		}
+		if i > 0 && location.Parent != indoor[i-1].Location {
+			t.Fatal(location.Location, "unexpected parent", location.Parent)
+		}
+	}
+	if indoor[len(indoor)-1].Location != path || indoor[0].Parent != "London, United Kingdom" {
+		t.Fatal("unexpected location path", indoor[0].Parent, indoor[len(indoor)-1].Location)
+	}
+}
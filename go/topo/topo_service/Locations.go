@@ -0,0 +1,34 @@
+package topo_service
+
+import "github.com/saichler/l8topology/go/types/l8topo"
+
+// MinLocationConfidence is the confidence below which a resolved location is listed for review
+const MinLocationConfidence = float32(0.8)
+
+// unresolvedLocationsOf returns a topology of the locations that could not be resolved to coordinates,
+// or were resolved with a confidence below the minimum, so they can be fixed in the inventory or by an alias
+func (this *TopoService) unresolvedLocationsOf(minConfidence float32) *l8topo.L8Topology {
+	if minConfidence <= 0 {
+		minConfidence = MinLocationConfidence
+	}
+	locations := this.locations.Collect(func(i interface{}) (bool, interface{}) {
+		return isUnresolved(i.(*l8topo.L8TopologyLocation), minConfidence), i
+	})
+	topology := &l8topo.L8Topology{Name: this.name}
+	topology.Locations = make(map[string]*l8topo.L8TopologyLocation, len(locations))
+	for _, l := range locations {
+		location := l.(*l8topo.L8TopologyLocation)
+		topology.Locations[location.Location] = location
+	}
+	return topology
+}
+
+func isUnresolved(location *l8topo.L8TopologyLocation, minConfidence float32) bool {
+	switch location.Resolution {
+	case l8topo.L8TopologyLocationResolution_Unresolved:
+		return true
+	case l8topo.L8TopologyLocationResolution_UnknownResolution, l8topo.L8TopologyLocationResolution_ProvidedResolution:
+		return false
+	}
+	return location.Confidence < minConfidence
+}
@@ -0,0 +1,243 @@
+package topo_service
+
+import (
+	"time"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+const (
+	metricsInterval = time.Second * 30
+)
+
+// defaultUtilizationBands are the utilization percentages where a link turns
+// Medium, High and Critical on the heatmap
+var defaultUtilizationBands = []float32{50, 75, 90}
+
+// IMetricsSource provides the interface counters of the ports behind the topology links.
+// It is passed to the topology service as an additional sla argument.
+type IMetricsSource interface {
+	Counters(endpoint *l8topo.L8TopologyLinkEndpoint) (*PortCounters, error)
+}
+
+// PortCounters is a sample of the cumulative counters of a port
+type PortCounters struct {
+	InOctets   uint64
+	OutOctets  uint64
+	InPackets  uint64
+	OutPackets uint64
+	InErrors   uint64
+	OutErrors  uint64
+	Time       time.Time
+}
+
+// pollMetrics periodically updates the utilization and error rates of the links
+func (this *TopoService) pollMetrics(interval time.Duration) {
+	samples := make(map[string]*PortCounters)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-this.stop:
+			return
+		case <-ticker.C:
+			samples = this.updateLinkMetrics(samples)
+		}
+	}
+}
+
+// updateLinkMetrics samples the counters of every link endpoint,
+// and updates the links from the difference with the previous samples.
+// It returns the samples of this poll, so the ports that are gone are not kept.
+func (this *TopoService) updateLinkMetrics(previous map[string]*PortCounters) map[string]*PortCounters {
+	samples := make(map[string]*PortCounters, len(previous))
+	links := this.links.Collect(func(i interface{}) (bool, interface{}) {
+		return true, i
+	})
+	for _, l := range links {
+		link := l.(*l8topo.L8TopologyLink)
+		asideOk := this.updateSideMetrics(link.AsideEndpoint, link.MemberAsideEndpoints, previous, samples)
+		zsideOk := this.updateSideMetrics(link.ZsideEndpoint, link.MemberZsideEndpoints, previous, samples)
+		if !asideOk && !zsideOk {
+			continue
+		}
+		err := this.patchLinkMetrics(link)
+		if err != nil {
+			this.vnic.Resources().Logger().Error("[Metrics] ", this.name, " failed to update link ", link.LinkId, ": ", err.Error())
+		}
+	}
+	return samples
+}
+
+// updateSideMetrics updates the metrics of a link side, a LAG side from the metrics of its member ports
+func (this *TopoService) updateSideMetrics(endpoint *l8topo.L8TopologyLinkEndpoint, members []*l8topo.L8TopologyLinkEndpoint,
+	previous, samples map[string]*PortCounters) bool {
+	if len(members) == 0 {
+		return this.updateEndpointMetrics(endpoint, previous, samples)
+	}
+	updated := false
+	for _, member := range members {
+		if this.updateEndpointMetrics(member, previous, samples) {
+			updated = true
+		}
+	}
+	if !updated || endpoint == nil {
+		return false
+	}
+	rollUpEndpointMetrics(endpoint, members)
+	return true
+}
+
+// rollUpEndpointMetrics sets the metrics of a LAG endpoint from its members,
+// the utilization averaged by speed and the worst error rates
+func rollUpEndpointMetrics(endpoint *l8topo.L8TopologyLinkEndpoint, members []*l8topo.L8TopologyLinkEndpoint) {
+	var speed, in, out float64
+	endpoint.InErrorRate = 0
+	endpoint.OutErrorRate = 0
+	for _, member := range members {
+		speed += float64(member.Speed)
+		in += float64(member.InUtilization) * float64(member.Speed)
+		out += float64(member.OutUtilization) * float64(member.Speed)
+		endpoint.InErrorRate = max(endpoint.InErrorRate, member.InErrorRate)
+		endpoint.OutErrorRate = max(endpoint.OutErrorRate, member.OutErrorRate)
+	}
+	if speed > 0 {
+		endpoint.InUtilization = float32(in / speed)
+		endpoint.OutUtilization = float32(out / speed)
+	}
+}
+
+// patchLinkMetrics sets only the metrics of a sampled link on the current link, so a discovery
+// or a patch of the link since it was sampled is kept. A link that was removed since is skipped.
+func (this *TopoService) patchLinkMetrics(sampled *l8topo.L8TopologyLink) error {
+	this.linksMtx.Lock()
+	defer this.linksMtx.Unlock()
+	l, err := this.links.Get(sampled)
+	if err != nil {
+		return nil
+	}
+	link := l.(*l8topo.L8TopologyLink)
+	if !setLinkMetrics(link, sampled) {
+		return nil
+	}
+	_, err = this.links.Put(link, false)
+	return err
+}
+
+// setLinkMetrics copies the endpoint metrics of the sampled link to the endpoints of the link that are
+// still the same ports, and sets the link utilization and error rate from them
+func setLinkMetrics(link, sampled *l8topo.L8TopologyLink) bool {
+	asideOk := copyEndpointMetrics(link.AsideEndpoint, sampled.AsideEndpoint)
+	zsideOk := copyEndpointMetrics(link.ZsideEndpoint, sampled.ZsideEndpoint)
+	if !asideOk && !zsideOk {
+		return false
+	}
+	link.Utilization = 0
+	link.ErrorRate = 0
+	for _, endpoint := range []*l8topo.L8TopologyLinkEndpoint{link.AsideEndpoint, link.ZsideEndpoint} {
+		if endpoint == nil {
+			continue
+		}
+		link.Utilization = max(link.Utilization, endpoint.InUtilization, endpoint.OutUtilization)
+		link.ErrorRate = max(link.ErrorRate, endpoint.InErrorRate, endpoint.OutErrorRate)
+	}
+	link.MetricsTime = time.Now().UnixMilli()
+	return true
+}
+
+func copyEndpointMetrics(endpoint, sampled *l8topo.L8TopologyLinkEndpoint) bool {
+	if endpoint == nil || sampled == nil || endpoint.NodeId != sampled.NodeId || endpoint.PortId != sampled.PortId {
+		return false
+	}
+	endpoint.InUtilization = sampled.InUtilization
+	endpoint.OutUtilization = sampled.OutUtilization
+	endpoint.InErrorRate = sampled.InErrorRate
+	endpoint.OutErrorRate = sampled.OutErrorRate
+	return true
+}
+
+func (this *TopoService) updateEndpointMetrics(endpoint *l8topo.L8TopologyLinkEndpoint, previous, samples map[string]*PortCounters) bool {
+	if endpoint == nil || endpoint.PortId == "" {
+		return false
+	}
+	counters, err := this.metrics.Counters(endpoint)
+	if err != nil || counters == nil {
+		return false
+	}
+	key := endpoint.NodeId + "/" + endpoint.PortId
+	samples[key] = counters
+	sample, ok := previous[key]
+	if !ok {
+		return false
+	}
+	return setEndpointMetrics(endpoint, sample, counters)
+}
+
+// setEndpointMetrics sets the utilization percentage and the error rate of each direction of the endpoint,
+// from two samples of its counters. It returns false if the samples cannot be compared,
+// for example when the counters were reset.
+func setEndpointMetrics(endpoint *l8topo.L8TopologyLinkEndpoint, previous, current *PortCounters) bool {
+	seconds := current.Time.Sub(previous.Time).Seconds()
+	if seconds <= 0 ||
+		current.InOctets < previous.InOctets || current.OutOctets < previous.OutOctets ||
+		current.InPackets < previous.InPackets || current.OutPackets < previous.OutPackets ||
+		current.InErrors < previous.InErrors || current.OutErrors < previous.OutErrors {
+		return false
+	}
+	if endpoint.Speed > 0 {
+		speed := float64(endpoint.Speed)
+		endpoint.InUtilization = float32(float64(current.InOctets-previous.InOctets) * 8 / seconds / speed * 100)
+		endpoint.OutUtilization = float32(float64(current.OutOctets-previous.OutOctets) * 8 / seconds / speed * 100)
+	}
+	endpoint.InErrorRate = errorRateOf(current.InErrors-previous.InErrors, current.InPackets-previous.InPackets)
+	endpoint.OutErrorRate = errorRateOf(current.OutErrors-previous.OutErrors, current.OutPackets-previous.OutPackets)
+	return true
+}
+
+func errorRateOf(errors, packets uint64) float32 {
+	if packets == 0 {
+		return 0
+	}
+	return float32(errors) / float32(packets)
+}
+
+// mergeLinkMetrics merges the metrics of a member link into its bundle, before its capacity is added.
+// The utilization is averaged, weighted by capacity, and the error rate is the worst of the members.
+func mergeLinkMetrics(bundle, member *l8topo.L8TopologyLink) {
+	if member.MetricsTime == 0 {
+		return
+	}
+	if bundle.MetricsTime == 0 {
+		bundle.Utilization = member.Utilization
+	} else if capacity := float32(bundle.Capacity + member.Capacity); capacity > 0 {
+		bundle.Utilization = (bundle.Utilization*float32(bundle.Capacity) + member.Utilization*float32(member.Capacity)) / capacity
+	} else {
+		bundle.Utilization = max(bundle.Utilization, member.Utilization)
+	}
+	bundle.ErrorRate = max(bundle.ErrorRate, member.ErrorRate)
+	bundle.MetricsTime = max(bundle.MetricsTime, member.MetricsTime)
+}
+
+// setUtilizationLevels colors the links of the topology by their utilization, for the heatmap view
+func setUtilizationLevels(topology *l8topo.L8Topology, bands []float32) {
+	if len(bands) == 0 {
+		bands = defaultUtilizationBands
+	}
+	for _, link := range topology.Links {
+		link.UtilizationLevel = utilizationLevelOf(link, bands)
+	}
+}
+
+func utilizationLevelOf(link *l8topo.L8TopologyLink, bands []float32) l8topo.L8TopologyUtilizationLevel {
+	if link.MetricsTime == 0 {
+		return l8topo.L8TopologyUtilizationLevel_UnknownUtilization
+	}
+	level := l8topo.L8TopologyUtilizationLevel_LowUtilization
+	for i, band := range bands {
+		if i > 2 || link.Utilization < band {
+			break
+		}
+		level = l8topo.L8TopologyUtilizationLevel(int32(l8topo.L8TopologyUtilizationLevel_LowUtilization) + int32(i) + 1)
+	}
+	return level
+}
@@ -0,0 +1,55 @@
+package topo_service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+// MockMetricsSource is a metrics source with counters that grow at a steady rate per port,
+// derived from the port id, for tests and demos without a collector
+type MockMetricsSource struct {
+	mtx      sync.Mutex
+	counters map[string]*PortCounters
+}
+
+func NewMockMetricsSource() *MockMetricsSource {
+	return &MockMetricsSource{counters: make(map[string]*PortCounters)}
+}
+
+func (this *MockMetricsSource) Counters(endpoint *l8topo.L8TopologyLinkEndpoint) (*PortCounters, error) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	now := time.Now()
+	key := endpoint.NodeId + "/" + endpoint.PortId
+	counters, ok := this.counters[key]
+	if !ok {
+		counters = &PortCounters{Time: now}
+		this.counters[key] = counters
+		result := *counters
+		return &result, nil
+	}
+
+	// Each port runs at a steady 5% to 99% of its speed, 1Gbps if unknown
+	hash := uint64(0)
+	for i := 0; i < len(key); i++ {
+		hash = hash*31 + uint64(key[i])
+	}
+	speed := endpoint.Speed
+	if speed == 0 {
+		speed = 1000000000
+	}
+	seconds := now.Sub(counters.Time).Seconds()
+	inOctets := uint64(float64(speed) * float64(5+hash%95) / 100 * seconds / 8)
+	outOctets := uint64(float64(speed) * float64(5+(hash/95)%95) / 100 * seconds / 8)
+	counters.InOctets += inOctets
+	counters.OutOctets += outOctets
+	counters.InPackets += inOctets / 1000
+	counters.OutPackets += outOctets / 1000
+	counters.InErrors += inOctets / 1000 * (hash % 3) / 10000
+	counters.OutErrors += outOctets / 1000 * ((hash / 3) % 3) / 10000
+	counters.Time = now
+	result := *counters
+	return &result, nil
+}
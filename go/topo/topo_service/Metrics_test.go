@@ -0,0 +1,137 @@
+package topo_service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+func TestEndpointMetrics(t *testing.T) {
+	now := time.Now()
+	endpoint := &l8topo.L8TopologyLinkEndpoint{NodeId: "R1", PortId: "1", Speed: 1000000000}
+	previous := &PortCounters{Time: now}
+	// 10 seconds of 630Mbps in and 120Mbps out, 1 error every 1000 packets in
+	current := &PortCounters{
+		InOctets:  630000000 / 8 * 10,
+		OutOctets: 120000000 / 8 * 10,
+		InPackets: 100000,
+		InErrors:  100,
+		Time:      now.Add(time.Second * 10),
+	}
+	if !setEndpointMetrics(endpoint, previous, current) {
+		t.Fatal("expected the samples to be comparable")
+	}
+	if int(endpoint.InUtilization+0.5) != 63 || int(endpoint.OutUtilization+0.5) != 12 {
+		t.Fatal("unexpected utilization", endpoint.InUtilization, endpoint.OutUtilization)
+	}
+	if endpoint.InErrorRate != 0.001 || endpoint.OutErrorRate != 0 {
+		t.Fatal("unexpected error rate", endpoint.InErrorRate, endpoint.OutErrorRate)
+	}
+	// Counters that went back were reset and are not comparable
+	if setEndpointMetrics(endpoint, current, previous) {
+		t.Fatal("expected reset counters to be skipped")
+	}
+}
+
+func TestUtilizationLevels(t *testing.T) {
+	levels := map[float32]l8topo.L8TopologyUtilizationLevel{
+		10: l8topo.L8TopologyUtilizationLevel_LowUtilization,
+		50: l8topo.L8TopologyUtilizationLevel_MediumUtilization,
+		80: l8topo.L8TopologyUtilizationLevel_HighUtilization,
+		95: l8topo.L8TopologyUtilizationLevel_CriticalUtilization,
+	}
+	for utilization, expected := range levels {
+		link := &l8topo.L8TopologyLink{Utilization: utilization, MetricsTime: 1}
+		if level := utilizationLevelOf(link, defaultUtilizationBands); level != expected {
+			t.Fatal("utilization", utilization, "expected", expected, "got", level)
+		}
+	}
+	if level := utilizationLevelOf(&l8topo.L8TopologyLink{}, defaultUtilizationBands); level != l8topo.L8TopologyUtilizationLevel_UnknownUtilization {
+		t.Fatal("a link without metrics should have an unknown utilization, got", level)
+	}
+}
+
+func TestMockMetricsSource(t *testing.T) {
+	source := NewMockMetricsSource()
+	endpoint := &l8topo.L8TopologyLinkEndpoint{NodeId: "R1", PortId: "1", Speed: 1000000000}
+	previous, _ := source.Counters(endpoint)
+	time.Sleep(time.Millisecond * 20)
+	current, _ := source.Counters(endpoint)
+	if !setEndpointMetrics(endpoint, previous, current) {
+		t.Fatal("expected the mock counters to be comparable")
+	}
+	if endpoint.InUtilization < 5 || endpoint.InUtilization > 100 || endpoint.OutUtilization < 5 || endpoint.OutUtilization > 100 {
+		t.Fatal("unexpected mock utilization", endpoint.InUtilization, endpoint.OutUtilization)
+	}
+}
+
+func TestSetLinkMetrics(t *testing.T) {
+	sampled := &l8topo.L8TopologyLink{LinkId: "l1",
+		AsideEndpoint: &l8topo.L8TopologyLinkEndpoint{NodeId: "R1", PortId: "1", InUtilization: 40, OutErrorRate: 0.01},
+		ZsideEndpoint: &l8topo.L8TopologyLinkEndpoint{NodeId: "R2", PortId: "1", OutUtilization: 70}}
+	// The link was rediscovered since the sample, its zside is now another port
+	link := &l8topo.L8TopologyLink{LinkId: "l1", Status: l8topo.L8TopologyLinkStatus_Down, Utilization: 99,
+		AsideEndpoint: &l8topo.L8TopologyLinkEndpoint{NodeId: "R1", PortId: "1", InterfaceName: "ge-0/0/1"},
+		ZsideEndpoint: &l8topo.L8TopologyLinkEndpoint{NodeId: "R2", PortId: "2"}}
+	if !setLinkMetrics(link, sampled) {
+		t.Fatal("expected the metrics of the same aside port to be set")
+	}
+	if link.Utilization != 40 || link.ErrorRate != 0.01 || link.ZsideEndpoint.OutUtilization != 0 {
+		t.Fatal("unexpected link metrics", link.Utilization, link.ErrorRate, link.ZsideEndpoint.OutUtilization)
+	}
+	if link.Status != l8topo.L8TopologyLinkStatus_Down || link.AsideEndpoint.InterfaceName != "ge-0/0/1" {
+		t.Fatal("expected the link details to be kept")
+	}
+}
+
+func TestDeActivate(t *testing.T) {
+	// A service whose activation failed has no metrics poll to stop
+	if (&TopoService{}).DeActivate() != nil {
+		t.Fatal("expected a service that was not activated to deactivate")
+	}
+}
+
+func TestRollUpEndpointMetrics(t *testing.T) {
+	endpoint := &l8topo.L8TopologyLinkEndpoint{NodeId: "R1"}
+	members := []*l8topo.L8TopologyLinkEndpoint{
+		{NodeId: "R1", PortId: "1", Speed: 1000000000, InUtilization: 10, OutUtilization: 20, InErrorRate: 0.01},
+		{NodeId: "R1", PortId: "2", Speed: 3000000000, InUtilization: 50, OutUtilization: 40, OutErrorRate: 0.02},
+	}
+	rollUpEndpointMetrics(endpoint, members)
+	if endpoint.InUtilization != 40 || endpoint.OutUtilization != 35 {
+		t.Fatal("unexpected utilization", endpoint.InUtilization, endpoint.OutUtilization)
+	}
+	if endpoint.InErrorRate != 0.01 || endpoint.OutErrorRate != 0.02 {
+		t.Fatal("unexpected error rates", endpoint.InErrorRate, endpoint.OutErrorRate)
+	}
+}
+
+func TestUpdateSideMetrics(t *testing.T) {
+	service := &TopoService{metrics: NewMockMetricsSource()}
+	endpoint := &l8topo.L8TopologyLinkEndpoint{NodeId: "R1"}
+	members := []*l8topo.L8TopologyLinkEndpoint{
+		{NodeId: "R1", PortId: "1", Speed: 1000000000},
+		{NodeId: "R1", PortId: "2", Speed: 1000000000},
+	}
+	samples := make(map[string]*PortCounters)
+	if service.updateSideMetrics(endpoint, members, map[string]*PortCounters{}, samples) {
+		t.Fatal("expected the first poll to only sample the members")
+	}
+	if len(samples) != 2 {
+		t.Fatal("expected a sample per member port, got", len(samples))
+	}
+	time.Sleep(time.Millisecond * 20)
+	// The second member is gone, its sample is not kept
+	previous := samples
+	samples = make(map[string]*PortCounters)
+	if !service.updateSideMetrics(endpoint, members[:1], previous, samples) {
+		t.Fatal("expected the bundle side to be updated from its member")
+	}
+	if _, ok := samples["R1/2"]; ok || len(samples) != 1 {
+		t.Fatal("expected only the sample of the remaining member, got", len(samples))
+	}
+	if endpoint.InUtilization == 0 || endpoint.InUtilization != members[0].InUtilization {
+		t.Fatal("unexpected bundle utilization", endpoint.InUtilization, members[0].InUtilization)
+	}
+}
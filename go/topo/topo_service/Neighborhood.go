@@ -0,0 +1,57 @@
+package topo_service
+
+import (
+	"errors"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+const (
+	defaultNeighborhoodHops = 1
+)
+
+// neighborhoodOf returns the ids of the nodes that are within the given number of hops from the center node
+func (this *TopoService) neighborhoodOf(centerNodeId string, hops int32) (map[string]bool, error) {
+	_, err := this.nodes.Get(&l8topo.L8TopologyNode{NodeId: centerNodeId})
+	if err != nil {
+		return nil, errors.New("unknown center node " + centerNodeId)
+	}
+	if hops <= 0 {
+		hops = defaultNeighborhoodHops
+	}
+
+	allLinks := this.links.Collect(func(i interface{}) (bool, interface{}) {
+		return true, i
+	})
+	adjacency := make(map[string]map[string]bool)
+	for _, l := range allLinks {
+		topolink := l.(*l8topo.L8TopologyLink)
+		aside := asideNodeIdOf(topolink)
+		zside := zsideNodeIdOf(topolink)
+		if adjacency[aside] == nil {
+			adjacency[aside] = make(map[string]bool)
+		}
+		if adjacency[zside] == nil {
+			adjacency[zside] = make(map[string]bool)
+		}
+		adjacency[aside][zside] = true
+		adjacency[zside][aside] = true
+	}
+
+	// BFS from the center, up to hops levels
+	neighborhood := map[string]bool{centerNodeId: true}
+	level := []string{centerNodeId}
+	for hop := int32(0); hop < hops && len(level) > 0; hop++ {
+		next := make([]string, 0)
+		for _, nodeId := range level {
+			for neighborId := range adjacency[nodeId] {
+				if !neighborhood[neighborId] {
+					neighborhood[neighborId] = true
+					next = append(next, neighborId)
+				}
+			}
+		}
+		level = next
+	}
+	return neighborhood, nil
+}
@@ -0,0 +1,51 @@
+package topo_service
+
+import (
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+func TestNeighborhoodOf(t *testing.T) {
+	service := newCachedService()
+	// A chain a-b-c-d, with e linked to b, and f without links
+	for _, nodeId := range []string{"a", "b", "c", "d", "e", "f"} {
+		if err := service.doNodes(ifs.POST, &l8topo.L8TopologyNode{NodeId: nodeId}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, sides := range [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"e", "b"}} {
+		link := createLink("port<{24}"+sides[0]+">", "port<{24}"+sides[1]+">", l8topo.L8TopologyLinkDirection_Bidirectional)
+		if err := service.doLinks(ifs.POST, link); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		name   string
+		center string
+		hops   int32
+		nodes  []string
+	}{
+		{name: "default hops", center: "a", nodes: []string{"a", "b"}},
+		{name: "two hops", center: "a", hops: 2, nodes: []string{"a", "b", "c", "e"}},
+		{name: "beyond the chain", center: "a", hops: 10, nodes: []string{"a", "b", "c", "d", "e"}},
+		// The links are followed in both directions
+		{name: "zside center", center: "c", hops: 1, nodes: []string{"b", "c", "d"}},
+		{name: "no links", center: "f", hops: 2, nodes: []string{"f"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			neighborhood, err := service.neighborhoodOf(test.center, test.hops)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !hasKeys(neighborhood, test.nodes) {
+				t.Fatal("expected", test.nodes, "got", neighborhood)
+			}
+		})
+	}
+	if _, err := service.neighborhoodOf("x", 1); err == nil {
+		t.Fatal("expected an unknown center node to fail")
+	}
+}
@@ -0,0 +1,164 @@
+package topo_service
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+const (
+	pagesTimeout = time.Minute * 2
+)
+
+// topologyPages is a laid out topology that is retrieved page by page,
+// so all the pages are cut from the same layout.
+// The nodes, with their locations, are paged first and then the links, the order a stream would send them in,
+// there is no separate streaming mode.
+// The pages are kept in the memory of the service instance that returned the first page,
+// so a cursor is valid only on that instance, a next page on another replica fails as an expired cursor.
+type topologyPages struct {
+	topology *l8topo.L8Topology
+	nodeKeys []string
+	linkKeys []string
+	// locations that are not the location of any node, such as the moved locations of a delta
+	locationKeys []string
+	accessed     time.Time
+}
+
+type pagesStore struct {
+	mtx   sync.Mutex
+	seq   int64
+	pages map[string]*topologyPages
+}
+
+func newPagesStore() *pagesStore {
+	store := &pagesStore{}
+	store.pages = make(map[string]*topologyPages)
+	return store
+}
+
+// firstPage keeps the topology for its next pages and returns its first page
+func (this *pagesStore) firstPage(topology *l8topo.L8Topology, pageSize int32) *l8topo.L8Topology {
+	pages := &topologyPages{topology: topology}
+	pages.nodeKeys = make([]string, 0, len(topology.Nodes))
+	for key := range topology.Nodes {
+		pages.nodeKeys = append(pages.nodeKeys, key)
+	}
+	sort.Strings(pages.nodeKeys)
+	pages.linkKeys = make([]string, 0, len(topology.Links))
+	for key := range topology.Links {
+		pages.linkKeys = append(pages.linkKeys, key)
+	}
+	sort.Strings(pages.linkKeys)
+	nodeLocations := make(map[string]bool, len(topology.Nodes))
+	for _, node := range topology.Nodes {
+		nodeLocations[node.Location] = true
+	}
+	pages.locationKeys = make([]string, 0)
+	for key := range topology.Locations {
+		if !nodeLocations[key] {
+			pages.locationKeys = append(pages.locationKeys, key)
+		}
+	}
+
+	this.mtx.Lock()
+	this.expire()
+	this.seq++
+	id := strconv.FormatInt(time.Now().UnixNano(), 36) + "-" + strconv.FormatInt(this.seq, 36)
+	pages.accessed = time.Now()
+	this.pages[id] = pages
+	this.mtx.Unlock()
+
+	return this.pageOf(id, pages, 0, pageSize)
+}
+
+// nextPage returns the page of the cursor, a cursor is "<pages id>:<offset>"
+func (this *pagesStore) nextPage(cursor string, pageSize int32) (*l8topo.L8Topology, error) {
+	index := strings.LastIndex(cursor, ":")
+	if index == -1 {
+		return nil, errors.New("invalid topology cursor " + cursor)
+	}
+	id := cursor[:index]
+	offset, err := strconv.Atoi(cursor[index+1:])
+	if err != nil || offset < 0 {
+		return nil, errors.New("invalid topology cursor " + cursor)
+	}
+	if pageSize <= 0 {
+		return nil, errors.New("a topology cursor requires a page size")
+	}
+
+	this.mtx.Lock()
+	this.expire()
+	pages, ok := this.pages[id]
+	if ok {
+		pages.accessed = time.Now()
+	}
+	this.mtx.Unlock()
+
+	if !ok {
+		return nil, errors.New("topology cursor " + cursor + " has expired")
+	}
+	return this.pageOf(id, pages, offset, pageSize), nil
+}
+
+// expire drops the paged topologies that were not accessed within the timeout
+func (this *pagesStore) expire() {
+	for id, pages := range this.pages {
+		if time.Since(pages.accessed) > pagesTimeout {
+			delete(this.pages, id)
+		}
+	}
+}
+
+func (this *pagesStore) pageOf(id string, pages *topologyPages, offset int, pageSize int32) *l8topo.L8Topology {
+	topology := pages.topology
+	page := &l8topo.L8Topology{Name: topology.Name, Width: topology.Width, Height: topology.Height}
+	page.Projection = topology.Projection
+	page.FloorPlanImage = topology.FloorPlanImage
+	page.Revision = topology.Revision
+	page.Delta = topology.Delta
+	page.TotalNodes = int32(len(pages.nodeKeys))
+	page.TotalLinks = int32(len(pages.linkKeys))
+	page.Nodes = make(map[string]*l8topo.L8TopologyNode)
+	page.Locations = make(map[string]*l8topo.L8TopologyLocation)
+	page.Links = make(map[string]*l8topo.L8TopologyLink)
+
+	// The first page carries what is not paged
+	if offset == 0 {
+		for _, key := range pages.locationKeys {
+			page.Locations[key] = topology.Locations[key]
+		}
+		page.RemovedNodes = topology.RemovedNodes
+		page.RemovedLinks = topology.RemovedLinks
+		page.RemovedLocations = topology.RemovedLocations
+	}
+
+	total := len(pages.nodeKeys) + len(pages.linkKeys)
+	end := min(offset+int(pageSize), total)
+	for i := offset; i < end; i++ {
+		if i < len(pages.nodeKeys) {
+			node := topology.Nodes[pages.nodeKeys[i]]
+			page.Nodes[node.NodeId] = node
+			if location, ok := topology.Locations[node.Location]; ok {
+				page.Locations[node.Location] = location
+			}
+			continue
+		}
+		link := topology.Links[pages.linkKeys[i-len(pages.nodeKeys)]]
+		page.Links[link.LinkId] = link
+	}
+
+	if end < total {
+		page.NextCursor = id + ":" + strconv.Itoa(end)
+	} else {
+		this.mtx.Lock()
+		delete(this.pages, id)
+		this.mtx.Unlock()
+	}
+	return page
+}
@@ -0,0 +1,78 @@
+package topo_service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+func pagingTopology() *l8topo.L8Topology {
+	topology := newTestTopology()
+	addTestNode(topology, "b", "London", 0, 0)
+	addTestNode(topology, "a", "Paris", 0, 0)
+	addTestNode(topology, "c", "Paris", 0, 0)
+	topology.Locations["Berlin"] = &l8topo.L8TopologyLocation{Location: "Berlin"}
+	addTestLink(topology, "b", "c")
+	addTestLink(topology, "a", "b")
+	topology.Revision = "r1"
+	topology.RemovedNodes = []string{"d"}
+	return topology
+}
+
+func TestPagingOrder(t *testing.T) {
+	store := newPagesStore()
+	tests := []struct {
+		nodes, links, locations []string
+		removed                 bool
+	}{
+		// The locations without nodes and the removed elements are on the first page
+		{nodes: []string{"a", "b"}, locations: []string{"Berlin", "London", "Paris"}, removed: true},
+		{nodes: []string{"c"}, links: []string{"a<->b"}, locations: []string{"Paris"}},
+		{links: []string{"b<->c"}},
+	}
+	page := store.firstPage(pagingTopology(), 2)
+	for i, test := range tests {
+		if i > 0 {
+			var err error
+			page, err = store.nextPage(page.NextCursor, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if page.TotalNodes != 3 || page.TotalLinks != 2 || page.Revision != "r1" {
+			t.Fatal("page", i, "unexpected totals", page.TotalNodes, page.TotalLinks, page.Revision)
+		}
+		if !hasKeys(page.Nodes, test.nodes) || !hasKeys(page.Links, test.links) || !hasKeys(page.Locations, test.locations) {
+			t.Fatal("page", i, "unexpected elements", page.Nodes, page.Links, page.Locations)
+		}
+		if (len(page.RemovedNodes) > 0) != test.removed {
+			t.Fatal("page", i, "unexpected removed nodes", page.RemovedNodes)
+		}
+	}
+	if page.NextCursor != "" {
+		t.Fatal("expected the last page to have no cursor")
+	}
+	if len(store.pages) != 0 {
+		t.Fatal("expected the pages to be dropped after the last page")
+	}
+}
+
+func TestPagingCursors(t *testing.T) {
+	store := newPagesStore()
+	page := store.firstPage(pagingTopology(), 1)
+	id := page.NextCursor[:strings.LastIndex(page.NextCursor, ":")]
+	for _, cursor := range []string{"nocursor", id + ":x", id + ":-1"} {
+		if _, err := store.nextPage(cursor, 1); err == nil {
+			t.Fatal("expected an invalid cursor to fail", cursor)
+		}
+	}
+	if _, err := store.nextPage(page.NextCursor, 0); err == nil {
+		t.Fatal("expected a cursor without a page size to fail")
+	}
+	store.pages[id].accessed = time.Now().Add(-pagesTimeout - time.Second)
+	if _, err := store.nextPage(page.NextCursor, 1); err == nil || !strings.Contains(err.Error(), "expired") {
+		t.Fatal("expected an expired cursor to fail", err)
+	}
+}
@@ -0,0 +1,70 @@
+package topo_service
+
+import (
+	"math"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+const (
+	pinnedMinDistance float32 = 40
+)
+
+// pinnedOf returns the user pinned positions of the given layout, keyed by node id
+func (this *TopoService) pinnedOf(layout l8topo.L8TopologyLayout) map[string]*l8topo.L8TopologyPinnedPosition {
+	pinned := make(map[string]*l8topo.L8TopologyPinnedPosition)
+	if this.pinned == nil {
+		return pinned
+	}
+	all := this.pinned.Collect(func(i interface{}) (bool, interface{}) {
+		p := i.(*l8topo.L8TopologyPinnedPosition)
+		return p.Layout == layout, i
+	})
+	for _, p := range all {
+		pin := p.(*l8topo.L8TopologyPinnedPosition)
+		pinned[pin.NodeId] = pin
+	}
+	return pinned
+}
+
+// isNearPinned returns true if the position is closer than distance to any pinned node in the view
+func isNearPinned(x, y, distance float32, nodes map[string]*l8topo.L8TopologyNode, pinned map[string]*l8topo.L8TopologyPinnedPosition) bool {
+	for nodeId, pin := range pinned {
+		if _, ok := nodes[nodeId]; !ok {
+			continue
+		}
+		dx := float64(pin.SvgX - x)
+		dy := float64(pin.SvgY - y)
+		if math.Sqrt(dx*dx+dy*dy) < float64(distance) {
+			return true
+		}
+	}
+	return false
+}
+
+// ringPositions distributes count positions evenly around a ring, starting from top (-π/2),
+// skipping ring slots that are occupied by pinned nodes
+func ringPositions(centerX, centerY, radius float32, count int, nodes map[string]*l8topo.L8TopologyNode, pinned map[string]*l8topo.L8TopologyPinnedPosition) []struct{ x, y float32 } {
+	var all []struct{ x, y float32 }
+	for extra := 0; extra <= len(pinned); extra++ {
+		slots := count + extra
+		all = make([]struct{ x, y float32 }, 0, slots)
+		free := make([]struct{ x, y float32 }, 0, slots)
+		for index := 0; index < slots; index++ {
+			angle := (2 * math.Pi * float64(index) / float64(slots)) - math.Pi/2
+			pos := struct{ x, y float32 }{
+				x: centerX + float32(float64(radius)*math.Cos(angle)),
+				y: centerY + float32(float64(radius)*math.Sin(angle)),
+			}
+			all = append(all, pos)
+			if !isNearPinned(pos.x, pos.y, pinnedMinDistance, nodes, pinned) {
+				free = append(free, pos)
+			}
+		}
+		if len(free) >= count {
+			return free[:count]
+		}
+	}
+	// Not enough free slots, overlap with the pinned nodes
+	return all[:count]
+}
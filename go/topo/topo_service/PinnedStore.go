@@ -0,0 +1,162 @@
+package topo_service
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/cache"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	pinnedDir    = "pinned"
+	pinnedDirMtx sync.RWMutex
+)
+
+// SetPinnedDir sets the directory where each topology keeps its pinned positions,
+// so they survive a restart, it takes effect on the next activation of a topology
+func SetPinnedDir(path string) {
+	pinnedDirMtx.Lock()
+	defer pinnedDirMtx.Unlock()
+	pinnedDir = path
+}
+
+// pinnedFileOf returns the file of the pinned positions of a topology service
+func pinnedFileOf(serviceName string, serviceArea byte) string {
+	pinnedDirMtx.RLock()
+	defer pinnedDirMtx.RUnlock()
+	return filepath.Join(pinnedDir, serviceName+"-"+strconv.Itoa(int(serviceArea))+".json")
+}
+
+// pinnedStore is the storage of the pinned positions cache,
+// a json file of an L8TopologyPinnedPositionList that is rewritten on every change
+type pinnedStore struct {
+	path string
+	mtx  sync.Mutex
+	pins map[string]*l8topo.L8TopologyPinnedPosition
+}
+
+// newPinnedStore loads the pinned positions of the file, a missing file has no pinned positions
+func newPinnedStore(path string) (*pinnedStore, error) {
+	this := &pinnedStore{path: path, pins: make(map[string]*l8topo.L8TopologyPinnedPosition)}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return this, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	list := &l8topo.L8TopologyPinnedPositionList{}
+	if err = protojson.Unmarshal(data, list); err != nil {
+		return nil, errors.New(path + ": " + err.Error())
+	}
+	// Keyed by their index until the cache that loads them keys them, see newPinnedCache
+	for i, pin := range list.List {
+		this.pins[strconv.Itoa(i)] = pin
+	}
+	return this, nil
+}
+
+// newPinnedCache returns the cache of the pinned positions of the file
+func newPinnedCache(path string, resources ifs.IResources) (*cache.Cache, error) {
+	store, err := newPinnedStore(path)
+	if err != nil {
+		return nil, err
+	}
+	pinned := cache.NewCache(&l8topo.L8TopologyPinnedPosition{}, nil, store, resources)
+	// The loaded positions are keyed by the primary key of the cache, so they can be replaced and deleted
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	pins := make(map[string]*l8topo.L8TopologyPinnedPosition, len(store.pins))
+	for _, pin := range store.pins {
+		key, _, err := pinned.KeysFor(pin)
+		if err != nil {
+			return nil, err
+		}
+		pins[key] = pin
+	}
+	store.pins = pins
+	return pinned, nil
+}
+
+func (this *pinnedStore) Put(key string, item interface{}) error {
+	pin, ok := item.(*l8topo.L8TopologyPinnedPosition)
+	if !ok {
+		return errors.New("not a topology pinned position")
+	}
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	this.pins[key] = pin
+	return this.save()
+}
+
+func (this *pinnedStore) Get(key string) (interface{}, error) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	pin, ok := this.pins[key]
+	if !ok {
+		return nil, errors.New("pinned position " + key + " not found")
+	}
+	return pin, nil
+}
+
+func (this *pinnedStore) Delete(key string) (interface{}, error) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	pin, ok := this.pins[key]
+	if !ok {
+		return nil, errors.New("pinned position " + key + " not found")
+	}
+	delete(this.pins, key)
+	return pin, this.save()
+}
+
+func (this *pinnedStore) Collect(f func(interface{}) (bool, interface{})) map[string]interface{} {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	result := make(map[string]interface{})
+	for key, pin := range this.pins {
+		ok, item := f(pin)
+		if ok {
+			result[key] = item
+		}
+	}
+	return result
+}
+
+// CacheEnabled keeps the pinned positions in the cache too, the file is only read on activation
+func (this *pinnedStore) CacheEnabled() bool {
+	return true
+}
+
+// save writes the pinned positions to a temporary file and renames it over the file,
+// so a failed write does not lose the previous positions
+func (this *pinnedStore) save() error {
+	keys := make([]string, 0, len(this.pins))
+	for key := range this.pins {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	list := &l8topo.L8TopologyPinnedPositionList{List: make([]*l8topo.L8TopologyPinnedPosition, 0, len(keys))}
+	for _, key := range keys {
+		list.List = append(list.List, this.pins[key])
+	}
+	data, err := protojson.Marshal(list)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(this.path), 0755); err != nil {
+		return err
+	}
+	tmp := this.path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, this.path)
+}
@@ -0,0 +1,148 @@
+package topo_service
+
+import (
+	"math"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+// chainTopology is a chain of nodes, each node is at the location of its id
+func chainTopology(count int) *l8topo.L8Topology {
+	topology := newTestTopology()
+	for i := 0; i < count; i++ {
+		nodeId := "n" + strconv.Itoa(i)
+		addTestNode(topology, nodeId, nodeId, 0, 0)
+		if i > 0 {
+			addTestLink(topology, "n"+strconv.Itoa(i-1), nodeId)
+		}
+	}
+	return topology
+}
+
+func TestPinnedLayouts(t *testing.T) {
+	layouts := []struct {
+		name   string
+		layout func(*l8topo.L8Topology, map[string]*l8topo.L8TopologyPinnedPosition)
+	}{
+		{name: "hierarchical", layout: Hierarchical},
+		{name: "circular", layout: Circular},
+		{name: "force directed", layout: Force_Directed},
+		{name: "radial", layout: func(topology *l8topo.L8Topology, pinned map[string]*l8topo.L8TopologyPinnedPosition) {
+			Radial(topology, "n0", pinned)
+		}},
+	}
+	for _, test := range layouts {
+		t.Run(test.name, func(t *testing.T) {
+			topology := chainTopology(8)
+			pinned := map[string]*l8topo.L8TopologyPinnedPosition{
+				"n3": {NodeId: "n3", SvgX: 700, SvgY: 200},
+				"n5": {NodeId: "n5", SvgX: 1500, SvgY: 600},
+				// A pin of a node that is not in the view is ignored
+				"n9": {NodeId: "n9", SvgX: 100, SvgY: 100},
+			}
+			test.layout(topology, pinned)
+			for nodeId, location := range topology.Locations {
+				if pin, ok := pinned[nodeId]; ok {
+					if location.SvgX != pin.SvgX || location.SvgY != pin.SvgY {
+						t.Fatal("expected", nodeId, "at its pinned position, got", location.SvgX, location.SvgY)
+					}
+					continue
+				}
+				for _, pin := range pinned {
+					if math.Hypot(float64(location.SvgX-pin.SvgX), float64(location.SvgY-pin.SvgY)) < 1 {
+						t.Fatal("expected", nodeId, "not to be placed on the pinned", pin.NodeId)
+					}
+				}
+			}
+			if _, ok := topology.Locations["n9"]; ok {
+				t.Fatal("expected the pin of a node that is not in the view to be ignored")
+			}
+		})
+	}
+}
+
+func TestRingPositions(t *testing.T) {
+	nodes := map[string]*l8topo.L8TopologyNode{"p": {NodeId: "p"}}
+	tests := []struct {
+		name   string
+		pinned map[string]*l8topo.L8TopologyPinnedPosition
+	}{
+		{name: "no pins"},
+		// The top slot of the ring is taken by the pinned node
+		{name: "pinned top slot", pinned: map[string]*l8topo.L8TopologyPinnedPosition{"p": {NodeId: "p", SvgX: 500, SvgY: 300}}},
+		{name: "pin of another node", pinned: map[string]*l8topo.L8TopologyPinnedPosition{"x": {NodeId: "x", SvgX: 500, SvgY: 300}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ring := ringPositions(500, 500, 200, 4, nodes, test.pinned)
+			if len(ring) != 4 {
+				t.Fatal("expected 4 positions, got", len(ring))
+			}
+			for _, pos := range ring {
+				if math.Abs(math.Hypot(float64(pos.x-500), float64(pos.y-500))-200) > 0.01 {
+					t.Fatal("expected the position on the ring", pos)
+				}
+				if isNearPinned(pos.x, pos.y, pinnedMinDistance, nodes, test.pinned) {
+					t.Fatal("expected the position away from the pinned nodes", pos)
+				}
+			}
+		})
+	}
+}
+
+func TestPinnedSurvivesReactivation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "topology-0.json")
+	resources := newTestResources()
+	pinned, err := newPinnedCache(path, resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := &TopoService{pinned: pinned}
+	pins := []*l8topo.L8TopologyPinnedPosition{
+		{NodeId: "n1", Layout: l8topo.L8TopologyLayout_Circular, SvgX: 100, SvgY: 200},
+		{NodeId: "n2", Layout: l8topo.L8TopologyLayout_Circular, SvgX: 300, SvgY: 400},
+		{NodeId: "n1", Layout: l8topo.L8TopologyLayout_Radial, SvgX: 500, SvgY: 600},
+	}
+	for _, pin := range pins {
+		if err = service.doPinned(ifs.POST, pin); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = service.doPinned(ifs.DELETE, pins[2]); err != nil {
+		t.Fatal(err)
+	}
+
+	// A re-activated service loads the pins, and can still move and remove them
+	pinned, err = newPinnedCache(path, resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service = &TopoService{pinned: pinned}
+	circular := service.pinnedOf(l8topo.L8TopologyLayout_Circular)
+	if len(circular) != 2 || circular["n1"].SvgX != 100 || circular["n2"].SvgY != 400 {
+		t.Fatal("expected the circular pins to be loaded", circular)
+	}
+	if radial := service.pinnedOf(l8topo.L8TopologyLayout_Radial); len(radial) != 0 {
+		t.Fatal("expected the deleted pin to stay deleted", radial)
+	}
+	moved := &l8topo.L8TopologyPinnedPosition{NodeId: "n1", Layout: l8topo.L8TopologyLayout_Circular, SvgX: 700, SvgY: 800}
+	if err = service.doPinned(ifs.PUT, moved); err != nil {
+		t.Fatal(err)
+	}
+	if err = service.doPinned(ifs.DELETE, pins[1]); err != nil {
+		t.Fatal(err)
+	}
+	pinned, err = newPinnedCache(path, resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service = &TopoService{pinned: pinned}
+	circular = service.pinnedOf(l8topo.L8TopologyLayout_Circular)
+	if len(circular) != 1 || circular["n1"].SvgX != 700 {
+		t.Fatal("expected the moved pin only", circular)
+	}
+}
@@ -0,0 +1,245 @@
+package topo_service
+
+import (
+	"errors"
+	"math"
+	"sync"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+// The registered projections, each is calibrated to its own map background.
+// The web UI ships resources/world.svg, the Robinson world map, and draws any other projection
+// on resources/<name>.svg, e.g. resources/lcc-europe.svg, which the deployment provides
+// at the size of the projection, see IProjection.Size.
+const (
+	RobinsonProjection        = "robinson"
+	WebMercatorProjection     = "mercator"
+	EquirectangularProjection = "equirectangular"
+	LambertEuropeProjection   = "lcc-europe"
+	LambertUSProjection       = "lcc-us"
+)
+
+// IProjection projects latitude and longitude to the coordinates of a map background of its size
+type IProjection interface {
+	Project(lat, lon float32) (float32, float32)
+	Size() (float32, float32)
+}
+
+var (
+	projections = map[string]IProjection{
+		RobinsonProjection:        NewRobinsonProjection(SimplemapsRobinson),
+		WebMercatorProjection:     NewMercatorProjection(-180, -85, 180, 85, svgWidth),
+		EquirectangularProjection: NewEquirectangularProjection(-180, -90, 180, 90, svgWidth),
+		// Europe from Iceland to the Urals, and the contiguous US, with the standard parallels of their conic maps
+		LambertEuropeProjection: NewLambertProjection(43, 62, 52, 10, -25, 34, 45, 72, svgWidth),
+		LambertUSProjection:     NewLambertProjection(33, 45, 39, -96, -125, 24, -66, 50, svgWidth),
+	}
+	projectionsMtx sync.RWMutex
+)
+
+// RegisterProjection adds a projection, e.g. for a regional map background, that a topology or a query can select by name.
+// A topology or a query of a projection that is not registered fails.
+func RegisterProjection(name string, projection IProjection) {
+	projectionsMtx.Lock()
+	defer projectionsMtx.Unlock()
+	projections[name] = projection
+}
+
+func projectionOf(name string) (IProjection, error) {
+	projectionsMtx.RLock()
+	defer projectionsMtx.RUnlock()
+	projection, ok := projections[name]
+	if !ok {
+		return nil, errors.New("unknown projection " + name)
+	}
+	return projection, nil
+}
+
+// projectLocation sets the map coordinates of the location from its latitude and longitude
+func projectLocation(location *l8topo.L8TopologyLocation, projection IProjection) {
+	location.SvgX, location.SvgY = projection.Project(location.Latitude, location.Longitude)
+}
+
+// RobinsonCalibration places the Robinson projection on a map background
+type RobinsonCalibration struct {
+	Width, Height float32
+	CenterX       float32 // X coordinate of longitude 0
+	EquatorY      float32 // Y coordinate of equator
+	EastScale     float32 // Pixels from CenterX to 180°E (plen=1)
+	WestScale     float32 // Pixels from CenterX to 180°W (plen=1)
+	NorthScale    float32 // Pixels from equator to the north pole (pdfe=1)
+	SouthScale    float32 // Pixels from equator to the south pole (pdfe=1)
+}
+
+// SimplemapsRobinson is the calibration of the Simplemaps 2000x857 Robinson projection world map
+var SimplemapsRobinson = RobinsonCalibration{
+	Width:      svgWidth,
+	Height:     svgHeight,
+	CenterX:    986,
+	EquatorY:   497,
+	EastScale:  1020,
+	WestScale:  1000,
+	NorthScale: 511,
+	SouthScale: 528,
+}
+
+// Robinson projection lookup table
+// Each entry: latitude (degrees), plen (parallel length), pdfe (distance from equator)
+var robinsonTable = []struct {
+	lat  float32
+	plen float32
+	pdfe float32
+}{
+	{0, 1.0000, 0.0000},
+	{5, 0.9986, 0.0620},
+	{10, 0.9954, 0.1240},
+	{15, 0.9900, 0.1860},
+	{20, 0.9822, 0.2480},
+	{25, 0.9730, 0.3100},
+	{30, 0.9600, 0.3720},
+	{35, 0.9427, 0.4340},
+	{40, 0.9216, 0.4958},
+	{45, 0.8962, 0.5571},
+	{50, 0.8679, 0.6176},
+	{55, 0.8350, 0.6769},
+	{60, 0.7986, 0.7346},
+	{65, 0.7597, 0.7903},
+	{70, 0.7186, 0.8435},
+	{75, 0.6732, 0.8936},
+	{80, 0.6213, 0.9394},
+	{85, 0.5722, 0.9761},
+	{90, 0.5322, 1.0000},
+}
+
+type robinsonProjection struct {
+	calibration RobinsonCalibration
+}
+
+func NewRobinsonProjection(calibration RobinsonCalibration) IProjection {
+	return &robinsonProjection{calibration: calibration}
+}
+
+func (this *robinsonProjection) Size() (float32, float32) {
+	return this.calibration.Width, this.calibration.Height
+}
+
+// Project converts latitude/longitude to map coordinates using the Robinson table
+func (this *robinsonProjection) Project(lat, lon float32) (float32, float32) {
+	// Get absolute latitude for table lookup
+	absLat := lat
+	if absLat < 0 {
+		absLat = -absLat
+	}
+
+	// Interpolate Robinson parameters
+	var plen, pdfe float32
+	if absLat >= 90 {
+		plen = 0.5322
+		pdfe = 1.0000
+	} else {
+		idx := int(absLat / 5)
+		t := (absLat - float32(idx)*5) / 5
+		row1 := robinsonTable[idx]
+		nextIdx := idx + 1
+		if nextIdx > 18 {
+			nextIdx = 18
+		}
+		row2 := robinsonTable[nextIdx]
+		plen = row1.plen + t*(row2.plen-row1.plen)
+		pdfe = row1.pdfe + t*(row2.pdfe-row1.pdfe)
+	}
+
+	// Calculate X coordinate
+	xScale := this.calibration.WestScale
+	if lon >= 0 {
+		xScale = this.calibration.EastScale
+	}
+	x := this.calibration.CenterX + (lon/180)*xScale*plen
+
+	// Calculate Y coordinate
+	if lat >= 0 {
+		return x, this.calibration.EquatorY - pdfe*this.calibration.NorthScale
+	}
+	return x, this.calibration.EquatorY + pdfe*this.calibration.SouthScale
+}
+
+// fittedProjection scales a projection so the west, south, east and north bounds of its map
+// fill a background of the given width, the height follows from the aspect ratio of the bounds
+type fittedProjection struct {
+	forward       func(lat, lon float64) (float64, float64)
+	minX, maxY    float64
+	scale         float64
+	width, height float32
+}
+
+func newFittedProjection(forward func(lat, lon float64) (float64, float64), west, south, east, north float64, width float32) *fittedProjection {
+	// The extremes of a conic projection may be anywhere along the bounds, so the bounds are sampled
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	const samples = 100
+	for i := 0; i <= samples; i++ {
+		lon := west + (east-west)*float64(i)/samples
+		lat := south + (north-south)*float64(i)/samples
+		for _, point := range [][2]float64{{south, lon}, {north, lon}, {lat, west}, {lat, east}} {
+			x, y := forward(point[0], point[1])
+			minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+			minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+		}
+	}
+	projection := &fittedProjection{forward: forward, minX: minX, maxY: maxY, width: width}
+	projection.scale = float64(width) / (maxX - minX)
+	projection.height = float32((maxY - minY) * projection.scale)
+	return projection
+}
+
+func (this *fittedProjection) Size() (float32, float32) {
+	return this.width, this.height
+}
+
+func (this *fittedProjection) Project(lat, lon float32) (float32, float32) {
+	x, y := this.forward(float64(lat), float64(lon))
+	return float32((x - this.minX) * this.scale), float32((this.maxY - y) * this.scale)
+}
+
+// NewMercatorProjection is the Web Mercator projection of the bounds, in degrees
+func NewMercatorProjection(west, south, east, north float64, width float32) IProjection {
+	const maxLat = 85.05112878
+	return newFittedProjection(func(lat, lon float64) (float64, float64) {
+		lat = math.Max(-maxLat, math.Min(maxLat, lat))
+		return radians(lon), math.Log(math.Tan(math.Pi/4 + radians(lat)/2))
+	}, west, south, east, north, width)
+}
+
+// NewEquirectangularProjection is the equirectangular projection of the bounds, in degrees
+func NewEquirectangularProjection(west, south, east, north float64, width float32) IProjection {
+	return newFittedProjection(func(lat, lon float64) (float64, float64) {
+		return lon, lat
+	}, west, south, east, north, width)
+}
+
+// NewLambertProjection is the Lambert conformal conic projection of the bounds, in degrees,
+// with the standard parallels lat1 and lat2 and the origin at lat0, lon0
+func NewLambertProjection(lat1, lat2, lat0, lon0, west, south, east, north float64, width float32) IProjection {
+	phi1, phi2 := radians(lat1), radians(lat2)
+	tanOf := func(phi float64) float64 {
+		return math.Tan(math.Pi/4 + phi/2)
+	}
+	n := math.Sin(phi1)
+	if lat1 != lat2 {
+		n = math.Log(math.Cos(phi1)/math.Cos(phi2)) / math.Log(tanOf(phi2)/tanOf(phi1))
+	}
+	f := math.Cos(phi1) * math.Pow(tanOf(phi1), n) / n
+	rho0 := f / math.Pow(tanOf(radians(lat0)), n)
+	return newFittedProjection(func(lat, lon float64) (float64, float64) {
+		// The poles are out of any regional map, keep the math finite
+		lat = math.Max(-89, math.Min(89, lat))
+		rho := f / math.Pow(tanOf(radians(lat)), n)
+		theta := n * radians(lon-lon0)
+		return rho * math.Sin(theta), rho0 - rho*math.Cos(theta)
+	}, west, south, east, north, width)
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
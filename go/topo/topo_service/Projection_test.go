@@ -0,0 +1,34 @@
+package topo_service
+
+import "testing"
+
+func TestProjectionsFitTheirMaps(t *testing.T) {
+	// A city inside the bounds of each map, and a city to its east
+	cities := map[string][2][2]float32{
+		RobinsonProjection:        {{40.7, -74}, {51.5, -0.12}},
+		WebMercatorProjection:     {{40.7, -74}, {51.5, -0.12}},
+		EquirectangularProjection: {{40.7, -74}, {51.5, -0.12}},
+		LambertEuropeProjection:   {{51.5, -0.12}, {52.5, 13.4}},
+		LambertUSProjection:       {{37.8, -122.4}, {40.7, -74}},
+	}
+	for name, pair := range cities {
+		projection, err := projectionOf(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		width, height := projection.Size()
+		wx, wy := projection.Project(pair[0][0], pair[0][1])
+		ex, ey := projection.Project(pair[1][0], pair[1][1])
+		for _, point := range [][2]float32{{wx, wy}, {ex, ey}} {
+			if point[0] < 0 || point[0] > width || point[1] < 0 || point[1] > height {
+				t.Fatal(name, "projected", point, "out of the map", width, height)
+			}
+		}
+		if wx >= ex {
+			t.Fatal(name, "expected", pair[1], "east of", pair[0])
+		}
+	}
+	if _, err := projectionOf("unknown"); err == nil {
+		t.Fatal("expected an unknown projection to fail")
+	}
+}
@@ -2,16 +2,20 @@ package topo_service
 
 import (
 	"math"
+	"sort"
 
 	"github.com/saichler/l8topology/go/types/l8topo"
 )
 
 const (
-	radialPadding float32 = 80
+	radialPadding        float32 = 80
 	radialMinRingSpacing float32 = 60
+	radialMinNodeSpacing float32 = 60
 )
 
-func Radial(topology *l8topo.L8Topology) {
+// Radial places the root node at the center and the other nodes in rings by their distance from it,
+// the root is the given root node, or the node with most connections if not given
+func Radial(topology *l8topo.L8Topology, rootId string, pinned map[string]*l8topo.L8TopologyPinnedPosition) {
 	nodes := topology.GetNodes()
 	links := topology.GetLinks()
 
@@ -19,10 +23,6 @@ func Radial(topology *l8topo.L8Topology) {
 		return
 	}
 
-	centerX := svgWidth / 2
-	centerY := svgHeight / 2
-	maxRadius := float32(math.Min(float64(svgWidth), float64(svgHeight)))/2 - radialPadding
-
 	// Build adjacency list
 	adjacency := make(map[string]map[string]bool)
 	for _, node := range nodes {
@@ -38,14 +38,16 @@ func Radial(topology *l8topo.L8Topology) {
 		}
 	}
 
-	// Find node with most connections as root
-	var rootNode *l8topo.L8TopologyNode
-	maxConnections := 0
-	for _, node := range nodes {
-		connCount := len(adjacency[node.NodeId])
-		if connCount > maxConnections || rootNode == nil {
-			maxConnections = connCount
-			rootNode = node
+	// Use the given root, or find node with most connections as root
+	rootNode := nodes[rootId]
+	if rootNode == nil {
+		maxConnections := 0
+		for _, node := range sortedNodes(nodes) {
+			connCount := len(adjacency[node.NodeId])
+			if connCount > maxConnections || rootNode == nil {
+				maxConnections = connCount
+				rootNode = node
+			}
 		}
 	}
 
@@ -96,15 +98,53 @@ func Radial(topology *l8topo.L8Topology) {
 		}
 	}
 
-	// Group nodes by level
-	levelGroups := make(map[int][]string)
-	for nodeId, level := range levels {
-		levelGroups[level] = append(levelGroups[level], nodeId)
+	// Grow the canvas so the rings are spaced and each ring has room for its nodes
+	levelCounts := make(map[int]int)
+	for _, level := range levels {
+		levelCounts[level]++
 	}
+	requiredRingSpacing := radialMinRingSpacing
+	for level, count := range levelCounts {
+		if level == 0 {
+			continue
+		}
+		spacing := float32(count) * radialMinNodeSpacing / (2 * math.Pi * float32(level))
+		if spacing > requiredRingSpacing {
+			requiredRingSpacing = spacing
+		}
+	}
+	growCanvasToFit(topology, 2*(requiredRingSpacing*float32(maxLevel+1)+radialPadding))
+
+	centerX := topology.Width / 2
+	centerY := topology.Height / 2
+	maxRadius := float32(math.Min(float64(topology.Width), float64(topology.Height)))/2 - radialPadding
 
 	// Calculate positions - radial layout with root at center
 	positions := make(map[string]struct{ x, y float32 })
 
+	// Pinned nodes keep their position, a pinned root moves the center
+	for nodeId, pin := range pinned {
+		if _, ok := nodes[nodeId]; ok {
+			positions[nodeId] = struct{ x, y float32 }{x: pin.SvgX, y: pin.SvgY}
+		}
+	}
+	if pos, ok := positions[rootNode.NodeId]; ok {
+		centerX = pos.x
+		centerY = pos.y
+	}
+
+	// Group the unpinned nodes by level
+	levelGroups := make(map[int][]string)
+	for nodeId, level := range levels {
+		if _, ok := positions[nodeId]; ok {
+			continue
+		}
+		levelGroups[level] = append(levelGroups[level], nodeId)
+	}
+	for _, nodesAtLevel := range levelGroups {
+		sort.Strings(nodesAtLevel)
+	}
+
 	// Calculate ring spacing
 	ringSpacing := maxRadius / float32(maxLevel+1)
 	if ringSpacing < radialMinRingSpacing && maxLevel > 0 {
@@ -124,14 +164,10 @@ func Radial(topology *l8topo.L8Topology) {
 				radius = maxRadius
 			}
 
-			nodeCountAtLevel := len(nodesAtLevel)
+			// Distribute nodes evenly around the ring, skipping pinned nodes
+			ring := ringPositions(centerX, centerY, radius, len(nodesAtLevel), nodes, pinned)
 			for index, nodeId := range nodesAtLevel {
-				// Distribute nodes evenly around the ring, starting from top (-π/2)
-				angle := (2*math.Pi*float64(index)/float64(nodeCountAtLevel)) - math.Pi/2
-				positions[nodeId] = struct{ x, y float32 }{
-					x: centerX + float32(float64(radius)*math.Cos(angle)),
-					y: centerY + float32(float64(radius)*math.Sin(angle)),
-				}
+				positions[nodeId] = ring[index]
 			}
 		}
 	}
@@ -0,0 +1,131 @@
+package topo_service
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	revisionsTimeout = time.Minute * 5
+	// maxRevisions bounds the kept views, e.g. of many polling clients, the least recently accessed is evicted first
+	maxRevisions = 64
+)
+
+type topologyRevision struct {
+	topology *l8topo.L8Topology
+	accessed time.Time
+}
+
+// revisionStore keeps the topology views that were returned to the clients by their revision token,
+// so a client that sends back its token receives only what changed since
+type revisionStore struct {
+	mtx       sync.Mutex
+	seq       int64
+	revisions map[string]*topologyRevision
+}
+
+func newRevisionStore() *revisionStore {
+	store := &revisionStore{}
+	store.revisions = make(map[string]*topologyRevision)
+	return store
+}
+
+// deltaOf stamps the topology with a revision token and returns it,
+// or only its changes since the given revision if that revision is still kept.
+// An unknown or expired revision returns the whole topology.
+func (this *revisionStore) deltaOf(topology *l8topo.L8Topology, revision string) *l8topo.L8Topology {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	this.expire()
+
+	previous, ok := this.revisions[revision]
+	if !ok {
+		topology.Revision = this.add(topology)
+		return topology
+	}
+
+	delta := &l8topo.L8Topology{Name: topology.Name, Width: topology.Width, Height: topology.Height, Delta: true}
+	delta.Projection = topology.Projection
+	delta.FloorPlanImage = topology.FloorPlanImage
+	delta.Nodes = make(map[string]*l8topo.L8TopologyNode)
+	delta.Links = make(map[string]*l8topo.L8TopologyLink)
+	delta.Locations = make(map[string]*l8topo.L8TopologyLocation)
+	for key, node := range topology.Nodes {
+		if old, ok := previous.topology.Nodes[key]; !ok || !proto.Equal(old, node) {
+			delta.Nodes[key] = node
+		}
+	}
+	for key := range previous.topology.Nodes {
+		if _, ok := topology.Nodes[key]; !ok {
+			delta.RemovedNodes = append(delta.RemovedNodes, key)
+		}
+	}
+	for key, link := range topology.Links {
+		if old, ok := previous.topology.Links[key]; !ok || !proto.Equal(old, link) {
+			delta.Links[key] = link
+		}
+	}
+	for key := range previous.topology.Links {
+		if _, ok := topology.Links[key]; !ok {
+			delta.RemovedLinks = append(delta.RemovedLinks, key)
+		}
+	}
+	for key, location := range topology.Locations {
+		if old, ok := previous.topology.Locations[key]; !ok || !proto.Equal(old, location) {
+			delta.Locations[key] = location
+		}
+	}
+	for key := range previous.topology.Locations {
+		if _, ok := topology.Locations[key]; !ok {
+			delta.RemovedLocations = append(delta.RemovedLocations, key)
+		}
+	}
+
+	// Nothing changed, the client stays on its revision
+	if len(delta.Nodes) == 0 && len(delta.Links) == 0 && len(delta.Locations) == 0 &&
+		len(delta.RemovedNodes) == 0 && len(delta.RemovedLinks) == 0 && len(delta.RemovedLocations) == 0 &&
+		previous.topology.Width == topology.Width && previous.topology.Height == topology.Height &&
+		previous.topology.Projection == topology.Projection && previous.topology.FloorPlanImage == topology.FloorPlanImage {
+		previous.accessed = time.Now()
+		delta.Revision = revision
+		return delta
+	}
+
+	// The client moves to the new revision, so its previous one is no longer needed
+	delete(this.revisions, revision)
+	delta.Revision = this.add(topology)
+	return delta
+}
+
+func (this *revisionStore) add(topology *l8topo.L8Topology) string {
+	if len(this.revisions) >= maxRevisions {
+		this.evictOldest()
+	}
+	this.seq++
+	revision := strconv.FormatInt(time.Now().UnixNano(), 36) + "-" + strconv.FormatInt(this.seq, 36)
+	this.revisions[revision] = &topologyRevision{topology: topology, accessed: time.Now()}
+	return revision
+}
+
+// expire drops the revisions that were not accessed within the timeout
+func (this *revisionStore) expire() {
+	for revision, kept := range this.revisions {
+		if time.Since(kept.accessed) > revisionsTimeout {
+			delete(this.revisions, revision)
+		}
+	}
+}
+
+func (this *revisionStore) evictOldest() {
+	oldest := ""
+	for revision, kept := range this.revisions {
+		if oldest == "" || kept.accessed.Before(this.revisions[oldest].accessed) {
+			oldest = revision
+		}
+	}
+	delete(this.revisions, oldest)
+}
@@ -0,0 +1,81 @@
+package topo_service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+func revisionTopology(nodes ...*l8topo.L8TopologyNode) *l8topo.L8Topology {
+	topology := &l8topo.L8Topology{Name: "test", Nodes: make(map[string]*l8topo.L8TopologyNode)}
+	for _, node := range nodes {
+		topology.Nodes[node.NodeId] = node
+	}
+	return topology
+}
+
+func TestDeltaOf(t *testing.T) {
+	store := newRevisionStore()
+	first := store.deltaOf(revisionTopology(&l8topo.L8TopologyNode{NodeId: "r1"}, &l8topo.L8TopologyNode{NodeId: "r2"}), "")
+	if first.Delta || first.Revision == "" {
+		t.Fatal("expected the whole topology with a revision")
+	}
+
+	unchanged := store.deltaOf(revisionTopology(&l8topo.L8TopologyNode{NodeId: "r1"}, &l8topo.L8TopologyNode{NodeId: "r2"}), first.Revision)
+	if !unchanged.Delta || unchanged.Revision != first.Revision || len(unchanged.Nodes) != 0 {
+		t.Fatal("expected an empty delta on the same revision", unchanged)
+	}
+
+	changed := store.deltaOf(revisionTopology(&l8topo.L8TopologyNode{NodeId: "r1", Name: "core-1"}, &l8topo.L8TopologyNode{NodeId: "r3"}), first.Revision)
+	if !changed.Delta || changed.Revision == first.Revision {
+		t.Fatal("expected a delta with a new revision", changed)
+	}
+	if len(changed.Nodes) != 2 || changed.Nodes["r1"] == nil || changed.Nodes["r3"] == nil {
+		t.Fatal("expected the changed and the added node", changed.Nodes)
+	}
+	if len(changed.RemovedNodes) != 1 || changed.RemovedNodes[0] != "r2" {
+		t.Fatal("expected the removed node", changed.RemovedNodes)
+	}
+	if _, ok := store.revisions[first.Revision]; ok {
+		t.Fatal("expected the previous revision to be dropped")
+	}
+
+	store.revisions[changed.Revision].accessed = time.Now().Add(-revisionsTimeout - time.Second)
+	expired := store.deltaOf(revisionTopology(&l8topo.L8TopologyNode{NodeId: "r1"}), changed.Revision)
+	if expired.Delta || len(expired.Nodes) != 1 {
+		t.Fatal("expected the whole topology for an expired revision", expired)
+	}
+}
+
+func TestRevisionsBound(t *testing.T) {
+	store := newRevisionStore()
+	first := store.deltaOf(revisionTopology(), "")
+	store.revisions[first.Revision].accessed = time.Now().Add(-time.Minute)
+	for i := 0; i < maxRevisions; i++ {
+		store.deltaOf(revisionTopology(), "")
+	}
+	if len(store.revisions) != maxRevisions {
+		t.Fatal("expected the revisions to be bounded", len(store.revisions))
+	}
+	if _, ok := store.revisions[first.Revision]; ok {
+		t.Fatal("expected the least recently accessed revision to be evicted")
+	}
+}
+
+func TestDeltaOfBackground(t *testing.T) {
+	store := newRevisionStore()
+	topology := revisionTopology(&l8topo.L8TopologyNode{NodeId: "r1"})
+	topology.Projection = "robinson"
+	first := store.deltaOf(topology, "")
+	// The same nodes on a floor plan are a change of the view background
+	plan := revisionTopology(&l8topo.L8TopologyNode{NodeId: "r1"})
+	plan.FloorPlanImage = "floor1.svg"
+	delta := store.deltaOf(plan, first.Revision)
+	if !delta.Delta || delta.Revision == first.Revision {
+		t.Fatal("expected a new revision for another background", delta)
+	}
+	if delta.Projection != "" || delta.FloorPlanImage != "floor1.svg" {
+		t.Fatal("expected the delta to carry the view background", delta.Projection, delta.FloorPlanImage)
+	}
+}
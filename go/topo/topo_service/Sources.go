@@ -0,0 +1,177 @@
+package topo_service
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+// IdentityRules are the node attributes that identify the same node in several discovery sources,
+// in the order they are tried. "name" is the node name.
+type IdentityRules []string
+
+var DefaultIdentityRules = IdentityRules{"serialNumber", "ipAddress", "name"}
+
+// IStaticDiscovery is a discovery whose elements are not requested from an inventory service,
+// e.g. a file of legacy devices
+type IStaticDiscovery interface {
+	StaticElements() ([]interface{}, error)
+}
+
+// discoverySource is one of the discoveries of a topology, named by its discovery
+type discoverySource struct {
+	name      string
+	discovery ITopoDiscovery
+}
+
+// newDiscoverySources names the discoveries, a name is unique in the topology
+func newDiscoverySources(discoveries []ITopoDiscovery) []*discoverySource {
+	sources := make([]*discoverySource, 0, len(discoveries))
+	names := make(map[string]bool)
+	for _, discovery := range discoveries {
+		name := nameOf(discovery)
+		if name == "" {
+			name = discovery.ServiceName() + "/" + strconv.Itoa(int(discovery.ServiceArea()))
+		}
+		unique := name
+		for i := 2; names[unique]; i++ {
+			unique = name + "#" + strconv.Itoa(i)
+		}
+		names[unique] = true
+		sources = append(sources, &discoverySource{name: unique, discovery: discovery})
+	}
+	return sources
+}
+
+// nodeMerger merges the nodes of the discovery sources. A node is the node of another source
+// with the same id, or with the same value of an identity rule.
+type nodeMerger struct {
+	rules      IdentityRules
+	nodes      []*l8topo.L8TopologyNode
+	byId       map[string]*l8topo.L8TopologyNode
+	byIdentity map[string]*l8topo.L8TopologyNode
+	merged     map[string]string
+}
+
+func newNodeMerger(rules IdentityRules) *nodeMerger {
+	return &nodeMerger{
+		rules:      rules,
+		byId:       make(map[string]*l8topo.L8TopologyNode),
+		byIdentity: make(map[string]*l8topo.L8TopologyNode),
+		merged:     make(map[string]string),
+	}
+}
+
+// add adds the node of a source and returns the id of the node it is merged into
+func (this *nodeMerger) add(source string, node *l8topo.L8TopologyNode) string {
+	existing := this.byId[node.NodeId]
+	if existing == nil {
+		existing = this.matchOf(source, node)
+	}
+	if existing == nil {
+		node.Sources = []string{source}
+		this.nodes = append(this.nodes, node)
+		existing = node
+	} else {
+		mergeNode(existing, node, source)
+	}
+	this.byId[node.NodeId] = existing
+	for _, key := range this.identitiesOf(node) {
+		if _, ok := this.byIdentity[key]; !ok {
+			this.byIdentity[key] = existing
+		}
+	}
+	this.merged[mergedKeyOf(source, node.NodeId)] = existing.NodeId
+	return existing.NodeId
+}
+
+// matchOf returns the node of another source with the first identity of the node,
+// the nodes of the same source are different nodes even with the same name
+func (this *nodeMerger) matchOf(source string, node *l8topo.L8TopologyNode) *l8topo.L8TopologyNode {
+	for _, key := range this.identitiesOf(node) {
+		existing, ok := this.byIdentity[key]
+		if ok && !hasSource(existing.Sources, source) {
+			return existing
+		}
+	}
+	return nil
+}
+
+func (this *nodeMerger) identitiesOf(node *l8topo.L8TopologyNode) []string {
+	return identitiesOf(this.rules, node)
+}
+
+// identitiesOf returns the identity rule values of a node, e.g. "serialNumber=fox1234"
+func identitiesOf(rules IdentityRules, node *l8topo.L8TopologyNode) []string {
+	keys := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		value := node.Attributes[rule]
+		if rule == "name" {
+			value = node.Name
+		}
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value != "" {
+			keys = append(keys, rule+"="+value)
+		}
+	}
+	return keys
+}
+
+// nodeIdOf returns the id of the node a node of a source is merged into
+func (this *nodeMerger) nodeIdOf(source, nodeId string) string {
+	id, ok := this.merged[mergedKeyOf(source, nodeId)]
+	if !ok {
+		return nodeId
+	}
+	return id
+}
+
+func mergedKeyOf(source, nodeId string) string {
+	return source + "/" + nodeId
+}
+
+// mergeNode adds the details of a source the merged node does not have yet, the first source wins
+func mergeNode(merged, node *l8topo.L8TopologyNode, source string) {
+	if !hasSource(merged.Sources, source) {
+		merged.Sources = append(merged.Sources, source)
+	}
+	if merged.Name == "" {
+		merged.Name = node.Name
+	}
+	if merged.Location == "" {
+		merged.Location = node.Location
+	}
+	if merged.Type == l8topo.L8TopologyNodeType_Generic {
+		merged.Type = node.Type
+	}
+	if merged.Status == l8topo.L8TopologyNodeStatus_InvalidNodeStatus {
+		merged.Status = node.Status
+	}
+	for name, value := range node.Attributes {
+		if _, ok := merged.Attributes[name]; ok {
+			continue
+		}
+		if merged.Attributes == nil {
+			merged.Attributes = make(map[string]string)
+		}
+		merged.Attributes[name] = value
+	}
+}
+
+func hasSource(sources []string, source string) bool {
+	for _, s := range sources {
+		if s == source {
+			return true
+		}
+	}
+	return false
+}
+
+// sourcesOf returns the sources of the two sides of a link, one source for a link inside a source
+func sourcesOf(aside, zside string) []string {
+	if aside == zside {
+		return []string{aside}
+	}
+	return []string{aside, zside}
+}
@@ -0,0 +1,81 @@
+package topo_service
+
+import (
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+type testPort struct {
+	id, neighbor string
+}
+
+// testDiscovery connects a port to the port named as its neighbor, and panics on a port named "panic"
+type testDiscovery struct {
+	name string
+}
+
+func (this *testDiscovery) ServiceName() string   { return this.name }
+func (this *testDiscovery) ServiceArea() byte     { return 0 }
+func (this *testDiscovery) Query() string         { return "" }
+func (this *testDiscovery) ModelTypeName() string { return "" }
+func (this *testDiscovery) IsConnected(aside, zside interface{}) (bool, l8topo.L8TopologyLinkDirection) {
+	a, z := aside.(*testPort), zside.(*testPort)
+	if a.id == "panic" || z.id == "panic" {
+		panic("bad port")
+	}
+	return a.neighbor == z.id || z.neighbor == a.id, l8topo.L8TopologyLinkDirection_Bidirectional
+}
+func (this *testDiscovery) ConvertToTopologyNode(elem interface{}) (*l8topo.L8TopologyNode, *l8topo.L8TopologyLocation) {
+	return elem.(*l8topo.L8TopologyNode), nil
+}
+func (this *testDiscovery) IdOf(elem interface{}) string                        { return "" }
+func (this *testDiscovery) LocationOf(elem interface{}) string                  { return "" }
+func (this *testDiscovery) NodeType(elem interface{}) l8topo.L8TopologyNodeType { return 0 }
+
+func TestNodeMerger(t *testing.T) {
+	merger := newNodeMerger(DefaultIdentityRules)
+	merger.add("inventory", &l8topo.L8TopologyNode{NodeId: "r1", Name: "core-1", Attributes: map[string]string{"serialNumber": "SN1"}})
+	merger.add("inventory", &l8topo.L8TopologyNode{NodeId: "r2", Name: "core-1"})
+	// Same serial in another source, with another id and name
+	id := merger.add("cloud", &l8topo.L8TopologyNode{NodeId: "i-1", Name: "Core-1.example", Attributes: map[string]string{"serialNumber": " sn1", "vendor": "x"}})
+	if id != "r1" || merger.nodeIdOf("cloud", "i-1") != "r1" {
+		t.Fatal("expected the cloud node to be merged by serial", id)
+	}
+	// Same name in another source
+	id = merger.add("legacy", &l8topo.L8TopologyNode{NodeId: "legacy-7", Name: "CORE-1"})
+	if id != "r1" {
+		t.Fatal("expected the legacy node to be merged by name", id)
+	}
+	if len(merger.nodes) != 2 {
+		t.Fatal("expected nodes of the same source with the same name not to be merged", len(merger.nodes))
+	}
+	merged := merger.nodes[0]
+	if len(merged.Sources) != 3 || merged.Attributes["vendor"] != "x" || merged.Attributes["serialNumber"] != "SN1" {
+		t.Fatal("unexpected merged node", merged.Sources, merged.Attributes)
+	}
+}
+
+func TestCrossSourceLinks(t *testing.T) {
+	sources := newDiscoverySources([]ITopoDiscovery{&testDiscovery{name: "a"}, &testDiscovery{name: "a"}})
+	if sources[0].name == sources[1].name {
+		t.Fatal("expected unique source names")
+	}
+	report := &l8topo.L8TopologyDiscoveryReport{}
+	list := []*elemEntry{
+		{source: sources[1], report: report, nodeId: "r4", elemId: "panic", elem: &testPort{id: "panic"}},
+		{source: sources[0], nodeId: "r1", elemId: "p1", elem: &testPort{id: "p1", neighbor: "p2"}},
+		{source: sources[1], nodeId: "r2", elemId: "p2", elem: &testPort{id: "p2"}},
+		{source: sources[1], nodeId: "r3", elemId: "x", elem: "not a port"},
+	}
+	links := (&TopoService{}).matchLinks(list)
+	if len(links) != 1 || len(links[0].Sources) != 2 {
+		t.Fatal("expected one cross source link", links)
+	}
+	if len(report.ConversionErrors) == 0 {
+		t.Fatal("expected the panic of a match to be reported")
+	}
+	if links[0].AsideEndpoint.NodeId != "r1" || links[0].ZsideEndpoint.NodeId != "r2" {
+		t.Fatal("unexpected link endpoints", links[0].AsideEndpoint, links[0].ZsideEndpoint)
+	}
+}
@@ -0,0 +1,146 @@
+package topo_service
+
+import (
+	"math"
+	"sync"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+)
+
+const (
+	spatialCellSize float32 = 50
+)
+
+type spatialCell struct {
+	x, y int
+}
+
+type spatialPoint struct {
+	x, y float32
+}
+
+// spatialIndex is a grid index over the SVG coordinates of the locations,
+// and the nodes of each location, so a bounding box query touches only the nodes inside the box
+type spatialIndex struct {
+	mtx           sync.RWMutex
+	cellSize      float32
+	cells         map[spatialCell]map[string]spatialPoint
+	locations     map[string]spatialPoint
+	locationNodes map[string]map[string]bool
+	nodeLocations map[string]string
+}
+
+func newSpatialIndex(cellSize float32) *spatialIndex {
+	index := &spatialIndex{}
+	index.cellSize = cellSize
+	index.cells = make(map[spatialCell]map[string]spatialPoint)
+	index.locations = make(map[string]spatialPoint)
+	index.locationNodes = make(map[string]map[string]bool)
+	index.nodeLocations = make(map[string]string)
+	return index
+}
+
+func (this *spatialIndex) cellOf(x, y float32) spatialCell {
+	return spatialCell{x: int(math.Floor(float64(x / this.cellSize))), y: int(math.Floor(float64(y / this.cellSize)))}
+}
+
+func (this *spatialIndex) putLocation(location *l8topo.L8TopologyLocation) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	this.deleteLocationNoLock(location.Location)
+	point := spatialPoint{x: location.SvgX, y: location.SvgY}
+	cell := this.cellOf(point.x, point.y)
+	locations, ok := this.cells[cell]
+	if !ok {
+		locations = make(map[string]spatialPoint)
+		this.cells[cell] = locations
+	}
+	locations[location.Location] = point
+	this.locations[location.Location] = point
+}
+
+func (this *spatialIndex) deleteLocation(location string) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	this.deleteLocationNoLock(location)
+}
+
+func (this *spatialIndex) deleteLocationNoLock(location string) {
+	point, ok := this.locations[location]
+	if !ok {
+		return
+	}
+	cell := this.cellOf(point.x, point.y)
+	delete(this.cells[cell], location)
+	if len(this.cells[cell]) == 0 {
+		delete(this.cells, cell)
+	}
+	delete(this.locations, location)
+}
+
+func (this *spatialIndex) putNode(node *l8topo.L8TopologyNode) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	this.deleteNodeNoLock(node.NodeId)
+	// An indoor node is on the map at its geographic location
+	location := GeoLocationOf(node.Location)
+	nodes, ok := this.locationNodes[location]
+	if !ok {
+		nodes = make(map[string]bool)
+		this.locationNodes[location] = nodes
+	}
+	nodes[node.NodeId] = true
+	this.nodeLocations[node.NodeId] = location
+}
+
+func (this *spatialIndex) deleteNode(nodeId string) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	this.deleteNodeNoLock(nodeId)
+}
+
+func (this *spatialIndex) deleteNodeNoLock(nodeId string) {
+	location, ok := this.nodeLocations[nodeId]
+	if !ok {
+		return
+	}
+	delete(this.locationNodes[location], nodeId)
+	if len(this.locationNodes[location]) == 0 {
+		delete(this.locationNodes, location)
+	}
+	delete(this.nodeLocations, nodeId)
+}
+
+// nodesIn returns the ids of the nodes whose location is inside the bounding box
+func (this *spatialIndex) nodesIn(x, y, x1, y1 float32) []string {
+	this.mtx.RLock()
+	defer this.mtx.RUnlock()
+	nodeIds := make([]string, 0)
+	addCell := func(locations map[string]spatialPoint) {
+		for location, point := range locations {
+			if point.x < x || point.x > x1 || point.y < y || point.y > y1 {
+				continue
+			}
+			for nodeId := range this.locationNodes[location] {
+				nodeIds = append(nodeIds, nodeId)
+			}
+		}
+	}
+	from := this.cellOf(x, y)
+	to := this.cellOf(x1, y1)
+	// A box larger than the populated cells scans the populated cells instead
+	if float64(to.x-from.x+1)*float64(to.y-from.y+1) > float64(len(this.cells)) {
+		for cell, locations := range this.cells {
+			if cell.x >= from.x && cell.x <= to.x && cell.y >= from.y && cell.y <= to.y {
+				addCell(locations)
+			}
+		}
+		return nodeIds
+	}
+	for cx := from.x; cx <= to.x; cx++ {
+		for cy := from.y; cy <= to.y; cy++ {
+			addCell(this.cells[spatialCell{x: cx, y: cy}])
+		}
+	}
+	return nodeIds
+}
@@ -0,0 +1,154 @@
+package topo_service
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/l8types/go/ifs"
+)
+
+const (
+	benchmarkNodes     = 100000
+	benchmarkLocations = 10000
+)
+
+func benchmarkTopology() ([]*l8topo.L8TopologyNode, map[string]*l8topo.L8TopologyLocation) {
+	random := rand.New(rand.NewSource(1))
+	locations := make(map[string]*l8topo.L8TopologyLocation, benchmarkLocations)
+	for i := 0; i < benchmarkLocations; i++ {
+		location := &l8topo.L8TopologyLocation{Location: "location-" + strconv.Itoa(i)}
+		location.SvgX = random.Float32() * svgWidth
+		location.SvgY = random.Float32() * svgHeight
+		locations[location.Location] = location
+	}
+	nodes := make([]*l8topo.L8TopologyNode, benchmarkNodes)
+	for i := 0; i < benchmarkNodes; i++ {
+		nodes[i] = &l8topo.L8TopologyNode{NodeId: "node-" + strconv.Itoa(i), Location: "location-" + strconv.Itoa(i%benchmarkLocations)}
+	}
+	return nodes, locations
+}
+
+// scanNodesIn is the bounding box query without the index, testing every node location
+func scanNodesIn(nodes []*l8topo.L8TopologyNode, locations map[string]*l8topo.L8TopologyLocation, x, y, x1, y1 float32) []string {
+	nodeIds := make([]string, 0)
+	for _, node := range nodes {
+		location, ok := locations[GeoLocationOf(node.Location)]
+		if !ok || location.SvgX < x || location.SvgX > x1 || location.SvgY < y || location.SvgY > y1 {
+			continue
+		}
+		nodeIds = append(nodeIds, node.NodeId)
+	}
+	return nodeIds
+}
+
+func TestNodesIn(t *testing.T) {
+	nodes, locations := benchmarkTopology()
+	index := newSpatialIndex(spatialCellSize)
+	for _, location := range locations {
+		index.putLocation(location)
+	}
+	for _, node := range nodes {
+		index.putNode(node)
+	}
+	tests := []struct {
+		name         string
+		x, y, x1, y1 float32
+	}{
+		{name: "small box", x: 900, y: 300, x1: 1100, y1: 400},
+		{name: "box inside one cell", x: 510, y: 510, x1: 540, y1: 540},
+		{name: "box on cell edges", x: 500, y: 250, x1: 750, y1: 500},
+		{name: "box larger than the map", x: -100, y: -100, x1: svgWidth + 100, y1: svgHeight + 100},
+		{name: "box outside the map", x: -300, y: -300, x1: -200, y1: -200},
+		{name: "empty box", x: 400, y: 400, x1: 300, y1: 300},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected := scanNodesIn(nodes, locations, test.x, test.y, test.x1, test.y1)
+			if actual := index.nodesIn(test.x, test.y, test.x1, test.y1); !sameIds(actual, expected) {
+				t.Fatal("expected", len(expected), "nodes, got", len(actual))
+			}
+		})
+	}
+}
+
+func TestIndexMaintenance(t *testing.T) {
+	service := newCachedService()
+	steps := []struct {
+		name     string
+		action   ifs.Action
+		elem     interface{}
+		inParis  []string
+		inLondon []string
+	}{
+		{name: "post locations", action: ifs.POST, elem: &l8topo.L8TopologyLocation{Location: "Paris", SvgX: 100, SvgY: 100}},
+		{name: "post london", action: ifs.POST, elem: &l8topo.L8TopologyLocation{Location: "London", SvgX: 300, SvgY: 300}},
+		{name: "post nodes", action: ifs.POST, elem: &l8topo.L8TopologyNode{NodeId: "a", Location: "Paris"}, inParis: []string{"a"}},
+		{name: "post indoor node", action: ifs.POST, elem: &l8topo.L8TopologyNode{NodeId: "b", Location: "Paris" + IndoorSeparator + "Building 1"},
+			inParis: []string{"a", "b"}},
+		{name: "patch node location", action: ifs.PATCH, elem: &l8topo.L8TopologyNode{NodeId: "a", Location: "London"},
+			inParis: []string{"b"}, inLondon: []string{"a"}},
+		// A partial patch keeps the indexed location of the node
+		{name: "patch node name", action: ifs.PATCH, elem: &l8topo.L8TopologyNode{NodeId: "a", Name: "A"},
+			inParis: []string{"b"}, inLondon: []string{"a"}},
+		{name: "move location", action: ifs.PATCH, elem: &l8topo.L8TopologyLocation{Location: "Paris", SvgX: 310, SvgY: 310},
+			inLondon: []string{"a", "b"}},
+		{name: "delete node", action: ifs.DELETE, elem: &l8topo.L8TopologyNode{NodeId: "a"}, inLondon: []string{"b"}},
+		{name: "delete location", action: ifs.DELETE, elem: &l8topo.L8TopologyLocation{Location: "Paris"}},
+	}
+	for _, step := range steps {
+		var err error
+		switch elem := step.elem.(type) {
+		case *l8topo.L8TopologyNode:
+			err = service.doNodes(step.action, elem)
+		case *l8topo.L8TopologyLocation:
+			err = service.doLocations(step.action, elem)
+		}
+		if err != nil {
+			t.Fatal(step.name, err)
+		}
+		if nodeIds := service.index.nodesIn(50, 50, 150, 150); !sameIds(nodeIds, step.inParis) {
+			t.Fatal(step.name, "expected", step.inParis, "around Paris, got", nodeIds)
+		}
+		if nodeIds := service.index.nodesIn(250, 250, 350, 350); !sameIds(nodeIds, step.inLondon) {
+			t.Fatal(step.name, "expected", step.inLondon, "around London, got", nodeIds)
+		}
+	}
+}
+
+// BenchmarkBoundingBoxScan is the bounding box query without the index
+func BenchmarkBoundingBoxScan(b *testing.B) {
+	nodes, locations := benchmarkTopology()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		scanNodesIn(nodes, locations, 900, 300, 1100, 400)
+	}
+}
+
+func BenchmarkBoundingBoxIndex(b *testing.B) {
+	nodes, locations := benchmarkTopology()
+	index := newSpatialIndex(spatialCellSize)
+	for _, location := range locations {
+		index.putLocation(location)
+	}
+	for _, node := range nodes {
+		index.putNode(node)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index.nodesIn(900, 300, 1100, 400)
+	}
+}
+
+func BenchmarkIndexUpdate(b *testing.B) {
+	nodes, locations := benchmarkTopology()
+	index := newSpatialIndex(spatialCellSize)
+	for _, location := range locations {
+		index.putLocation(location)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index.putNode(nodes[i%benchmarkNodes])
+	}
+}
@@ -0,0 +1,61 @@
+package topo_service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/saichler/l8topology/go/topo/topo_list"
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"google.golang.org/protobuf/proto"
+)
+
+// topologyStatus is the topology list entry of the service, updated by its discoveries
+type topologyStatus struct {
+	mtx  sync.Mutex
+	meta *l8topo.L8TopologyMetadata
+}
+
+func newTopologyStatus(name, description, serviceName string, serviceArea byte) *topologyStatus {
+	if name == "" {
+		name = serviceName
+	}
+	meta := &l8topo.L8TopologyMetadata{}
+	meta.Name = name
+	meta.Description = description
+	meta.ServiceName = serviceName
+	meta.ServiceArea = int32(serviceArea)
+	meta.Status = l8topo.L8TopologyStatus_TopologyDiscovering
+	return &topologyStatus{meta: meta}
+}
+
+// metadata returns a copy of the entry, to post without holding the lock
+func (this *topologyStatus) metadata() *l8topo.L8TopologyMetadata {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	return proto.Clone(this.meta).(*l8topo.L8TopologyMetadata)
+}
+
+// discovered records the result of a discovery, a failed one keeps the counts of the last successful one.
+// A discovery that built the topology from some of its sources is healthy, with the error of the other sources.
+func (this *topologyStatus) discovered(nodes, links int, err error, built bool) {
+	this.mtx.Lock()
+	defer this.mtx.Unlock()
+	this.meta.LastDiscovery = time.Now().UnixMilli()
+	this.meta.Error = ""
+	if err != nil {
+		this.meta.Error = err.Error()
+	}
+	if !built {
+		this.meta.Status = l8topo.L8TopologyStatus_TopologyFailed
+		return
+	}
+	this.meta.Status = l8topo.L8TopologyStatus_TopologyHealthy
+	this.meta.NodeCount = int32(nodes)
+	this.meta.LinkCount = int32(links)
+}
+
+// reportDiscovery updates the topology list entry with the result of a discovery
+func (this *TopoService) reportDiscovery(err error, built bool) {
+	this.status.discovered(this.nodes.Size(), this.links.Size(), err, built)
+	topo_list.UpdateTopology(this.status.metadata(), this.vnic)
+}
@@ -2,9 +2,10 @@ package topo_service
 
 import (
 	"errors"
-	"time"
+	"sync"
 
 	"github.com/saichler/l8srlz/go/serialize/object"
+	"github.com/saichler/l8topology/go/topo/topo_list"
 	"github.com/saichler/l8topology/go/types/l8topo"
 	"github.com/saichler/l8types/go/ifs"
 	"github.com/saichler/l8utils/go/utils/cache"
@@ -17,8 +18,22 @@ type TopoService struct {
 	name        string
 	nodes       *cache.Cache
 	links       *cache.Cache
+	linksMtx    sync.Mutex
 	locations   *cache.Cache
+	pinned      *cache.Cache
+	index       *spatialIndex
+	pages       *pagesStore
+	revisions   *revisionStore
+	reports     *reportsStore
 	discovery   ITopoDiscovery
+	sources     []*discoverySource
+	rules       IdentityRules
+	projection  string
+	metrics     IMetricsSource
+	stop        chan struct{}
+	stopOnce    sync.Once
+	vnic        ifs.IVNic
+	status      *topologyStatus
 }
 
 type ITopoDiscovery interface {
@@ -37,27 +52,74 @@ func (this *TopoService) Activate(sla *ifs.ServiceLevelAgreement, vnic ifs.IVNic
 	this.serviceName = sla.ServiceName()
 	this.serviceArea = sla.ServiceArea()
 	this.name = this.serviceName
+	this.vnic = vnic
+	// The first discovery names the topology, the other discoveries are more sources of its nodes and links
 	this.discovery = sla.Args()[0].(ITopoDiscovery)
+	this.projection = projectionOfDiscovery(this.discovery)
+	if _, err := projectionOf(this.projection); err != nil {
+		return err
+	}
+	discoveries := []ITopoDiscovery{this.discovery}
+	this.rules = DefaultIdentityRules
+	for _, arg := range sla.Args()[1:] {
+		metrics, ok := arg.(IMetricsSource)
+		if ok {
+			this.metrics = metrics
+		}
+		discovery, ok := arg.(ITopoDiscovery)
+		if ok {
+			discoveries = append(discoveries, discovery)
+		}
+		rules, ok := arg.(IdentityRules)
+		if ok && len(rules) > 0 {
+			this.rules = rules
+		}
+	}
+	this.sources = newDiscoverySources(discoveries)
 
 	vnic.Resources().Introspector().Decorators().AddPrimaryKeyDecorator(&l8topo.L8TopologyNode{}, "NodeId")
 	vnic.Resources().Introspector().Decorators().AddPrimaryKeyDecorator(&l8topo.L8TopologyLink{}, "LinkId")
 	vnic.Resources().Introspector().Decorators().AddPrimaryKeyDecorator(&l8topo.L8TopologyLocation{}, "Location")
+	vnic.Resources().Introspector().Decorators().AddPrimaryKeyDecorator(&l8topo.L8TopologyPinnedPosition{}, "NodeId", "Layout")
 
 	vnic.Resources().Registry().Register(&l8topo.L8TopologyQuery{})
+	vnic.Resources().Registry().Register(&l8topo.L8TopologyPinnedPosition{})
 
 	this.nodes = cache.NewCache(&l8topo.L8TopologyNode{}, nil, nil, vnic.Resources())
 	this.links = cache.NewCache(&l8topo.L8TopologyLink{}, nil, nil, vnic.Resources())
 	this.locations = cache.NewCache(&l8topo.L8TopologyLocation{}, nil, nil, vnic.Resources())
+	pinned, err := newPinnedCache(pinnedFileOf(this.serviceName, this.serviceArea), vnic.Resources())
+	if err != nil {
+		return err
+	}
+	this.pinned = pinned
+	this.index = newSpatialIndex(spatialCellSize)
+	this.pages = newPagesStore()
+	this.revisions = newRevisionStore()
+	this.reports = newReportsStore()
+	this.stop = make(chan struct{})
+	this.status = newTopologyStatus(nameOf(this.discovery), descriptionOf(this.discovery), this.serviceName, this.serviceArea)
+	topo_list.UpdateTopology(this.status.metadata(), vnic)
 
-	go func() {
-		time.Sleep(time.Second * 5)
-		this.DiscoverNodes(vnic)
-	}()
+	go this.discover(vnic)
+
+	if this.metrics != nil {
+		go this.pollMetrics(metricsInterval)
+	}
 
 	return nil
 }
 
+// DeActivate stops the discovery and removes the topology from the list, once,
+// a service whose activation failed before it started has nothing to stop
 func (this *TopoService) DeActivate() error {
+	this.stopOnce.Do(func() {
+		if this.stop == nil {
+			return
+		}
+		close(this.stop)
+		topo_list.RemoveTopology(this.serviceName, this.serviceArea, this.vnic)
+	})
 	return nil
 }
 
@@ -84,6 +146,14 @@ func (this *TopoService) do(action ifs.Action, elements ifs.IElements) error {
 			if err != nil {
 				return err
 			}
+			continue
+		}
+		pinned, ok := elem.(*l8topo.L8TopologyPinnedPosition)
+		if ok {
+			err := this.doPinned(action, pinned)
+			if err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -103,10 +173,26 @@ func (this *TopoService) doNodes(action ifs.Action, node *l8topo.L8TopologyNode)
 	default:
 		return errors.New("unknown action for topology nodes")
 	}
-	return err
+	if err != nil {
+		return err
+	}
+	if action == ifs.DELETE {
+		this.index.deleteNode(node.NodeId)
+		return nil
+	}
+	// A patch may be partial, index the patched node
+	indexed, err := this.nodes.Get(node)
+	if err != nil {
+		return err
+	}
+	this.index.putNode(indexed.(*l8topo.L8TopologyNode))
+	return nil
 }
 
 func (this *TopoService) doLinks(action ifs.Action, link *l8topo.L8TopologyLink) error {
+	// The links are also updated by the metrics poll
+	this.linksMtx.Lock()
+	defer this.linksMtx.Unlock()
 	var err error
 	switch action {
 	case ifs.POST:
@@ -137,6 +223,36 @@ func (this *TopoService) doLocations(action ifs.Action, location *l8topo.L8Topol
 	default:
 		return errors.New("unknown action for topology location")
 	}
+	if err != nil {
+		return err
+	}
+	if action == ifs.DELETE {
+		this.index.deleteLocation(location.Location)
+		return nil
+	}
+	// A patch may be partial, index the patched location
+	indexed, err := this.locations.Get(location)
+	if err != nil {
+		return err
+	}
+	this.index.putLocation(indexed.(*l8topo.L8TopologyLocation))
+	return nil
+}
+
+func (this *TopoService) doPinned(action ifs.Action, pinned *l8topo.L8TopologyPinnedPosition) error {
+	var err error
+	switch action {
+	case ifs.POST:
+		_, err = this.pinned.Post(pinned, false)
+	case ifs.PUT:
+		_, err = this.pinned.Put(pinned, false)
+	case ifs.DELETE:
+		_, err = this.pinned.Delete(pinned, false)
+	case ifs.PATCH:
+		_, err = this.pinned.Patch(pinned, false)
+	default:
+		return errors.New("unknown action for topology pinned position")
+	}
 	return err
 }
 
@@ -182,10 +298,10 @@ func (this *TopoService) TransactionConfig() ifs.ITransactionConfig {
 
 func (this *TopoService) WebService() ifs.IWebService {
 	return web.New(this.serviceName, this.serviceArea,
+		&l8topo.L8TopologyPinnedPosition{}, nil,
+		&l8topo.L8TopologyPinnedPosition{}, nil,
 		nil, nil,
-		nil, nil,
-		nil, nil,
-		nil, nil,
+		&l8topo.L8TopologyPinnedPosition{}, nil,
 		&l8topo.L8TopologyQuery{}, &l8topo.L8Topology{})
 }
 
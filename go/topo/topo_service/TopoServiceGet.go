@@ -2,6 +2,8 @@ package topo_service
 
 import (
 	"bytes"
+	"errors"
+	"sort"
 
 	"github.com/saichler/l8srlz/go/serialize/object"
 	"github.com/saichler/l8topology/go/types/l8topo"
@@ -18,110 +20,286 @@ func (this *TopoService) nodeL8Location(location string) *l8topo.L8TopologyLocat
 	return nil
 }
 
-func (this *TopoService) createViewNode(node *l8topo.L8TopologyNode, tq *l8topo.L8TopologyQuery, nodeIds map[string]bool) (*l8topo.L8TopologyNode, *l8topo.L8TopologyLocation, string) {
-	nodeLocation := this.nodeL8Location(node.Location)
-	if tq.X != 0 || tq.Y != 0 || tq.X1 != 0 || tq.Y1 != 0 {
+// topoView is where the view locations of a query are placed, when it is not the topology projection,
+// by another projection or on a floor plan
+type topoView struct {
+	reproject IProjection
+	floorPlan string
+}
+
+// nodesOf returns the nodes of the query bounding box from the spatial index, or all nodes if there is no box.
+// The index is of the topology projection, a query with another placement filters its box on the view locations.
+func (this *TopoService) nodesOf(tq *l8topo.L8TopologyQuery, view *topoView) []*l8topo.L8TopologyNode {
+	if !hasBoundingBox(tq) || view.reproject != nil || view.floorPlan != "" {
+		allNodes := this.nodes.Collect(func(i interface{}) (bool, interface{}) {
+			return true, i
+		})
+		nodes := make([]*l8topo.L8TopologyNode, 0, len(allNodes))
+		for _, n := range allNodes {
+			nodes = append(nodes, n.(*l8topo.L8TopologyNode))
+		}
+		return nodes
+	}
+	nodeIds := this.index.nodesIn(tq.X, tq.Y, tq.X1, tq.Y1)
+	nodes := make([]*l8topo.L8TopologyNode, 0, len(nodeIds))
+	for _, nodeId := range nodeIds {
+		n, err := this.nodes.Get(&l8topo.L8TopologyNode{NodeId: nodeId})
+		if err == nil {
+			nodes = append(nodes, n.(*l8topo.L8TopologyNode))
+		}
+	}
+	return nodes
+}
+
+// createViewNode returns the view node and view location that the node is aggregated into, and their key,
+// nodeIds maps the id of each node in the view to the key of its view node
+func (this *TopoService) createViewNode(node *l8topo.L8TopologyNode, tq *l8topo.L8TopologyQuery, aggregation l8topo.L8TopologyAggregation, nodeIds map[string]string, view *topoView) (*l8topo.L8TopologyNode, *l8topo.L8TopologyLocation, string) {
+	var nodeLocation *l8topo.L8TopologyLocation
+	if view.floorPlan != "" {
+		nodeLocation = this.planLocationOf(node.Location, view.floorPlan)
+		if nodeLocation == nil {
+			return nil, nil, ""
+		}
+	} else {
+		// An indoor location is placed on the map at its geographic location
+		nodeLocation = this.nodeL8Location(GeoLocationOf(node.Location))
+		if nodeLocation == nil {
+			nodeLocation = &l8topo.L8TopologyLocation{Location: node.Location}
+		}
+		if view.reproject != nil {
+			projectLocation(nodeLocation, view.reproject)
+		}
+	}
+	if hasBoundingBox(tq) {
 		if nodeLocation.SvgX < tq.X || nodeLocation.SvgX > tq.X1 ||
 			nodeLocation.SvgY < tq.Y || nodeLocation.SvgY > tq.Y1 {
 			return nil, nil, ""
 		}
 	}
+	viewKey := aggregationKeyOf(node, nodeLocation, aggregation, tq.GroupByAttribute)
 	viewNode := &l8topo.L8TopologyNode{}
-	if tq.Layout == l8topo.L8TopologyLayout_Location {
-		viewNode.NodeId = node.Location
-		viewNode.Name = node.Location
-		viewNode.Location = node.Location
-	} else {
-		viewNode.NodeId = node.NodeId
+	viewNode.NodeId = viewKey
+	viewNode.Name = viewKey
+	viewNode.Location = viewKey
+	if aggregation == l8topo.L8TopologyAggregation_Device {
 		viewNode.Name = node.Name
-		viewNode.Location = node.NodeId
-		nodeLocation = &l8topo.L8TopologyLocation{}
-		nodeLocation.Location = node.NodeId
+	} else {
+		viewNode.MemberIds = []string{node.NodeId}
 	}
 	viewNode.Type = node.Type
-	nodeIds[node.NodeId] = true
-	return viewNode, nodeLocation, viewNode.Location
+	viewNode.Status = node.Status
+	viewNode.Attributes = copyAttributes(node.Attributes)
+	if tq.Layout == l8topo.L8TopologyLayout_Location || tq.Layout == l8topo.L8TopologyLayout_Floor_Plan {
+		nodeLocation.Location = viewKey
+	} else {
+		nodeLocation = &l8topo.L8TopologyLocation{}
+		nodeLocation.Location = viewKey
+	}
+	nodeIds[node.NodeId] = viewKey
+	return viewNode, nodeLocation, viewKey
 }
 
-func (this *TopoService) collectNodes(topology *l8topo.L8Topology, tq *l8topo.L8TopologyQuery, nodeIds map[string]bool) {
-	allNodes := this.nodes.Collect(func(i interface{}) (bool, interface{}) {
-		return true, i
+func (this *TopoService) collectNodes(topology *l8topo.L8Topology, tq *l8topo.L8TopologyQuery, aggregation l8topo.L8TopologyAggregation,
+	filter *topoFilter, nodeIds map[string]string, view *topoView) {
+	allNodes := this.nodesOf(tq, view)
+	// Aggregate in a stable order, so an unchanged topology returns the same view
+	sort.Slice(allNodes, func(i, j int) bool {
+		return allNodes[i].NodeId < allNodes[j].NodeId
 	})
 	topology.Nodes = make(map[string]*l8topo.L8TopologyNode)
 	topology.Locations = make(map[string]*l8topo.L8TopologyLocation)
-	for _, n := range allNodes {
-		node := n.(*l8topo.L8TopologyNode)
-		viewNode, viewLocation, viewKey := this.createViewNode(node, tq, nodeIds)
+	for _, node := range allNodes {
+		if !filter.acceptNode(node) {
+			continue
+		}
+		viewNode, viewLocation, viewKey := this.createViewNode(node, tq, aggregation, nodeIds, view)
 		if viewNode != nil {
 			exist, ok := topology.Nodes[viewKey]
 			if !ok {
 				topology.Nodes[viewKey] = viewNode
 				viewNode.Count = 1
+				topology.Locations[viewLocation.Location] = viewLocation
 			} else {
+				// Aggregated locations are placed at the center of their members
+				existLocation := topology.Locations[viewLocation.Location]
+				count := float32(exist.Count)
+				existLocation.SvgX = (existLocation.SvgX*count + viewLocation.SvgX) / (count + 1)
+				existLocation.SvgY = (existLocation.SvgY*count + viewLocation.SvgY) / (count + 1)
+				existLocation.Latitude = (existLocation.Latitude*count + viewLocation.Latitude) / (count + 1)
+				existLocation.Longitude = (existLocation.Longitude*count + viewLocation.Longitude) / (count + 1)
 				exist.Count += 1
 				exist.Type = l8topo.L8TopologyNodeType_NETWORK_AGGREGATION
+				exist.Status = aggregateNodeStatus(exist.Status, viewNode.Status)
+				exist.MemberIds = append(exist.MemberIds, viewNode.MemberIds...)
+				exist.Attributes = commonAttributes(exist.Attributes, viewNode.Attributes)
+				existLocation.Attributes = commonAttributes(existLocation.Attributes, viewLocation.Attributes)
 			}
-			topology.Locations[viewLocation.Location] = viewLocation
 		}
 	}
 }
 
-func (this *TopoService) collectLinks(topology *l8topo.L8Topology, tq *l8topo.L8TopologyQuery, nodeIds map[string]bool) {
+func (this *TopoService) collectLinks(topology *l8topo.L8Topology, tq *l8topo.L8TopologyQuery, filter *topoFilter, nodeIds map[string]string) {
 	allLinks := this.links.Collect(func(i interface{}) (bool, interface{}) {
 		return true, i
 	})
+	// Bundle in a stable order, so an unchanged topology returns the same view
+	linkKeys := make([]string, 0, len(allLinks))
+	for key := range allLinks {
+		linkKeys = append(linkKeys, key)
+	}
+	sort.Strings(linkKeys)
 	topology.Links = make(map[string]*l8topo.L8TopologyLink)
-	for _, l := range allLinks {
-		topolink := l.(*l8topo.L8TopologyLink)
-		aside := rootIdOf(topolink.Aside, nodeIds)
-		zside := rootIdOf(topolink.Zside, nodeIds)
-		//one of the nodes is not in query
-		if aside == "" || zside == "" {
+	for _, key := range linkKeys {
+		topolink := allLinks[key].(*l8topo.L8TopologyLink)
+		if !filter.acceptLink(topolink) {
 			continue
 		}
-		laside := aside
-		lzside := zside
-		if tq.Layout == l8topo.L8TopologyLayout_Location {
-			laside = this.locationOf(aside)
-			lzside = this.locationOf(zside)
+		aside := rootIdOf(asideNodeIdOf(topolink), nodeIds)
+		zside := rootIdOf(zsideNodeIdOf(topolink), nodeIds)
+		//one of the nodes is not in query, or was filtered out
+		if aside == "" || zside == "" {
+			continue
 		}
-		// the nodes have the same location
+		laside := nodeIds[aside]
+		lzside := nodeIds[zside]
+		// the nodes are aggregated into the same view node
 		if laside == lzside {
 			continue
 		}
 		buff := bytes.Buffer{}
 		buff.WriteString(laside)
 		buff.WriteString(lzside)
-		viewLink := createLink(laside, lzside, topolink.Direction)
-		viewLink.LinkId = buff.String()
-		exist, ok := topology.Links[viewLink.LinkId]
-		if ok {
-			if exist.Direction != topolink.Direction {
-				exist.Direction = l8topo.L8TopologyLinkDirection_Bidirectional
-			}
-		} else {
+		viewLink, ok := topology.Links[buff.String()]
+		if !ok {
+			viewLink = createLink(laside, lzside, topolink.Direction)
+			viewLink.LinkId = buff.String()
+			viewLink.Status = l8topo.L8TopologyLinkStatus_InvalidStatus
 			topology.Links[viewLink.LinkId] = viewLink
 		}
+		// Parallel links between the two view nodes are bundled into the view link
+		addLinkMember(viewLink, topolink.LinkId, topolink)
+		setEndpointNodes(viewLink, laside, lzside)
 	}
 }
 
 func (this *TopoService) Get(elements ifs.IElements, vnic ifs.IVNic) ifs.IElements {
 	tq := elements.Element().(*l8topo.L8TopologyQuery)
-	topology := &l8topo.L8Topology{Name: this.name}
-	nodeIds := make(map[string]bool)
-	this.collectNodes(topology, tq, nodeIds)
-	this.collectLinks(topology, tq, nodeIds)
-	if tq.Layout != l8topo.L8TopologyLayout_Location {
+	// Next pages are cut from the topology of the first page
+	if tq.Cursor != "" {
+		page, err := this.pages.nextPage(tq.Cursor, tq.PageSize)
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		return object.New(nil, page)
+	}
+	// The responses that are not a view of the topology have no revision and are not paged
+	topology, ok, err := this.responseOf(tq)
+	if ok {
+		if err != nil {
+			return object.NewError(err.Error())
+		}
+		return object.New(nil, topology)
+	}
+	topology, err = this.topologyOf(tq)
+	if err != nil {
+		return object.NewError(err.Error())
+	}
+	topology = this.revisions.deltaOf(topology, tq.Revision)
+	if tq.PageSize > 0 {
+		return object.New(nil, this.pages.firstPage(topology, tq.PageSize))
+	}
+	return object.New(nil, topology)
+}
+
+// responseOf returns the response of a query that is not a view of the topology, if it is one
+func (this *TopoService) responseOf(tq *l8topo.L8TopologyQuery) (*l8topo.L8Topology, bool, error) {
+	if tq.Raw {
+		return this.rawOf(), true, nil
+	}
+	if tq.UnresolvedLocations {
+		return this.unresolvedLocationsOf(tq.MinConfidence), true, nil
+	}
+	if tq.DiscoveryReports {
+		return this.discoveryReportsOf(), true, nil
+	}
+	if tq.MapServiceName != "" {
+		topology, err := this.crossLayerOf(tq)
+		return topology, true, err
+	}
+	return nil, false, nil
+}
+
+func (this *TopoService) topologyOf(tq *l8topo.L8TopologyQuery) (*l8topo.L8Topology, error) {
+	filter, err := newTopoFilter(tq)
+	if err != nil {
+		return nil, err
+	}
+	// A neighborhood query defaults to a Radial layout around its center node
+	if tq.CenterNodeId != "" {
+		filter.nodeIds, err = this.neighborhoodOf(tq.CenterNodeId, tq.Hops)
+		if err != nil {
+			return nil, err
+		}
+		if tq.Layout == l8topo.L8TopologyLayout_Location {
+			tq.Layout = l8topo.L8TopologyLayout_Radial
+		}
+	}
+	// A query may select another projection than the topology, e.g. for a regional map background
+	projectionName := tq.Projection
+	if projectionName == "" {
+		projectionName = this.projection
+	}
+	projection, err := projectionOf(projectionName)
+	if err != nil {
+		return nil, err
+	}
+	// The zoom of a bounding box is relative to the width of the projection map
+	mapWidth, _ := projection.Size()
+	aggregation := aggregationOf(tq, mapWidth)
+	if aggregation == l8topo.L8TopologyAggregation_Attribute && tq.GroupByAttribute == "" {
+		return nil, errors.New("attribute aggregation requires a group by attribute")
+	}
+	view := &topoView{}
+	if projectionName != this.projection {
+		view.reproject = projection
+	}
+	topology := &l8topo.L8Topology{Name: this.name, Projection: projectionName}
+	setCanvas(topology, tq, projection)
+	// A floor plan view places the devices of a building, a floor or a room on its plan image
+	if tq.Layout == l8topo.L8TopologyLayout_Floor_Plan {
+		plan, err := this.floorPlanOf(tq)
+		if err != nil {
+			return nil, err
+		}
+		view.floorPlan = plan.Location
+		setFloorPlanCanvas(topology, plan)
+	}
+	nodeIds := make(map[string]string)
+	this.collectNodes(topology, tq, aggregation, filter, nodeIds, view)
+	this.collectLinks(topology, tq, filter, nodeIds)
+	if tq.Layout == l8topo.L8TopologyLayout_Floor_Plan {
+		spreadLocations(topology, geoMarkerSpacing/zoomOf(tq, topology.Width))
+	} else if tq.Layout != l8topo.L8TopologyLayout_Location {
+		pinned := this.pinnedOf(tq.Layout)
 		switch tq.Layout {
 		case l8topo.L8TopologyLayout_Hierarchical:
-			Hierarchical(topology)
+			Hierarchical(topology, pinned)
 		case l8topo.L8TopologyLayout_Circular:
-			Circular(topology)
+			Circular(topology, pinned)
 		case l8topo.L8TopologyLayout_Radial:
-			Radial(topology)
+			Radial(topology, tq.CenterNodeId, pinned)
 		case l8topo.L8TopologyLayout_Force_Directed:
-			Force_Directed(topology)
+			Force_Directed(topology, pinned)
 		}
+		fitCanvas(topology)
+	} else {
+		Geographic(topology, zoomOf(tq, mapWidth))
 	}
-	return object.New(nil, topology)
+	if tq.Heatmap {
+		setUtilizationLevels(topology, tq.UtilizationBands)
+	}
+	if tq.RouteEdges {
+		RouteEdges(topology)
+	}
+	return topology, nil
 }
@@ -2,8 +2,10 @@ package topo_service
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/saichler/l8reflect/go/reflect/properties"
@@ -12,75 +14,231 @@ import (
 	"github.com/saichler/l8types/go/ifs"
 )
 
-func (this *TopoService) DiscoverNodes(vnic ifs.IVNic) {
-	fmt.Println("DiscoverNodes")
-	query := this.discovery.Query()
-	resp := vnic.LeaderRequest(this.discovery.ServiceName(), this.discovery.ServiceArea(), ifs.GET, query, 60)
-	fmt.Println("Received Response")
+// sourceElements are the inventory elements of a discovery source, with the report of their discovery
+type sourceElements struct {
+	source   *discoverySource
+	report   *l8topo.L8TopologyDiscoveryReport
+	elems    []interface{}
+	err      error
+	retained bool
+}
+
+// DiscoverNodes runs a discovery of the topology nodes and links from all its sources, and records a report per source.
+// A failed source does not fail the others, the topology is built from the sources that responded.
+func (this *TopoService) DiscoverNodes(vnic ifs.IVNic) ([]*l8topo.L8TopologyDiscoveryReport, error) {
+	return this.discoverSources(make(map[string][]interface{}), vnic)
+}
+
+// discoverSources fetches the sources that have not responded yet, and merges their elements with the elements
+// of the sources that responded to an earlier attempt, which are not fetched again. The responded sources are added
+// to responded. When all the sources responded, the nodes and links that no source reported are removed.
+func (this *TopoService) discoverSources(responded map[string][]interface{}, vnic ifs.IVNic) ([]*l8topo.L8TopologyDiscoveryReport, error) {
+	reports := make([]*l8topo.L8TopologyDiscoveryReport, 0, len(this.sources))
+	fetched := make([]*sourceElements, 0, len(this.sources))
+	for _, source := range this.sources {
+		elems, ok := responded[source.name]
+		if ok {
+			// Its report was recorded when it responded, the merge updates a report that is not kept
+			fetched = append(fetched, &sourceElements{source: source, report: &l8topo.L8TopologyDiscoveryReport{}, elems: elems, retained: true})
+			continue
+		}
+		report := this.reports.start(source.name)
+		reports = append(reports, report)
+		elems, err := this.elementsFrom(source, report, vnic)
+		fetched = append(fetched, &sourceElements{source: source, report: report, elems: elems, err: err})
+	}
+	nodes, links := this.discoverNodes(fetched, vnic)
+
+	errs := make([]error, 0)
+	built := false
+	for _, f := range fetched {
+		if f.err != nil {
+			errs = append(errs, errors.New(f.source.name+": "+f.err.Error()))
+		} else {
+			built = true
+			responded[f.source.name] = f.elems
+		}
+		if !f.retained {
+			this.reports.end(f.report, f.err)
+		}
+	}
+	err := errors.Join(errs...)
+	if err == nil {
+		this.removeUnreported(nodes, links)
+	}
+	this.reportDiscovery(err, built)
+	return reports, err
+}
+
+// removeUnreported removes the nodes and links that are not in the discovered ones,
+// e.g. devices that were removed from the inventory since the previous discovery
+func (this *TopoService) removeUnreported(nodes []*l8topo.L8TopologyNode, links []*l8topo.L8TopologyLink) {
+	nodeIds := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		nodeIds[node.NodeId] = true
+	}
+	linkIds := make(map[string]bool, len(links))
+	for _, link := range links {
+		linkIds[link.LinkId] = true
+	}
+	removedLinks := this.links.Collect(func(i interface{}) (bool, interface{}) {
+		return !linkIds[i.(*l8topo.L8TopologyLink).LinkId], i
+	})
+	for _, link := range removedLinks {
+		this.doLinks(ifs.DELETE, link.(*l8topo.L8TopologyLink))
+	}
+	removedNodes := this.nodes.Collect(func(i interface{}) (bool, interface{}) {
+		return !nodeIds[i.(*l8topo.L8TopologyNode).NodeId], i
+	})
+	for _, node := range removedNodes {
+		this.doNodes(ifs.DELETE, node.(*l8topo.L8TopologyNode))
+	}
+}
+
+// elementsFrom returns the elements of a source, from its inventory service or from the source itself if it is static
+func (this *TopoService) elementsFrom(source *discoverySource, report *l8topo.L8TopologyDiscoveryReport, vnic ifs.IVNic) ([]interface{}, error) {
+	static, ok := source.discovery.(IStaticDiscovery)
+	if ok {
+		report.ResponseStatus = "static"
+		return static.StaticElements()
+	}
+	query := source.discovery.Query()
+	resp := vnic.LeaderRequest(source.discovery.ServiceName(), source.discovery.ServiceArea(), ifs.GET, query, 60)
 	if resp == nil {
-		fmt.Println("received nil response")
-	} else if resp.Error() != nil {
-		fmt.Println("Received error response ", resp.Error().Error())
+		report.ResponseStatus = "no response"
+		return nil, errors.New("no response from " + source.discovery.ServiceName())
+	}
+	if resp.Error() != nil {
+		report.ResponseStatus = "error"
+		return nil, resp.Error()
 	}
+	report.ResponseStatus = "ok"
+	return elementsOf(resp)
+}
 
-	this.discoverNodes(resp, vnic)
+// elementsOf returns the inventory elements of a response, either a list of elements or a single list element
+func elementsOf(elements ifs.IElements) ([]interface{}, error) {
+	if len(elements.Elements()) > 1 {
+		return elements.Elements(), nil
+	}
+	v := reflect.ValueOf(elements.Element())
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil, errors.New("the response has no elements")
+	}
+	fldList := v.FieldByName("List")
+	if !fldList.IsValid() || fldList.Kind() != reflect.Slice {
+		return nil, errors.New("response element " + v.Type().Name() + " has no List attribute")
+	}
+	result := make([]interface{}, 0, fldList.Len())
+	for i := 0; i < fldList.Len(); i++ {
+		result = append(result, fldList.Index(i).Interface())
+	}
+	return result, nil
 }
 
-func (this *TopoService) discoverNodes(elements ifs.IElements, vnic ifs.IVNic) {
-	nodes := []interface{}{}
-	topoNodes := []*l8topo.L8TopologyNode{}
+// discoverNodes converts the elements of the sources that responded to nodes,
+// merging the nodes that are the same node in several sources, and returns the discovered nodes and links
+func (this *TopoService) discoverNodes(fetched []*sourceElements, vnic ifs.IVNic) ([]*l8topo.L8TopologyNode, []*l8topo.L8TopologyLink) {
+	projection, _ := projectionOf(this.projection)
+	merger := newNodeMerger(this.rules)
 	topoLocations := map[string]*l8topo.L8TopologyLocation{}
 
-	if len(elements.Elements()) > 1 {
-		fmt.Println("Element list size=", len(elements.Elements()))
-		for _, elem := range elements.Elements() {
-			nodes = append(nodes, elem)
-			topoNode, topoLocation := this.discovery.ConvertToTopologyNode(elem)
-			topoNodes = append(topoNodes, topoNode)
-			topoLocations[topoLocation.Location] = topoLocation
-		}
-	} else {
-		v := reflect.ValueOf(elements.Element())
-		if v.Kind() == reflect.Ptr {
-			v = v.Elem()
+	for _, f := range fetched {
+		if f.err != nil {
+			continue
 		}
-		if !v.IsValid() {
-			return
+		converted := make([]interface{}, 0, len(f.elems))
+		unresolved := make(map[string]bool)
+		for _, elem := range f.elems {
+			topoNode, topoLocation, err := convertNode(f.source.discovery, elem)
+			if err != nil {
+				addConversionError(f.report, err)
+				continue
+			}
+			converted = append(converted, elem)
+			merger.add(f.source.name, topoNode)
+			if topoLocation != nil {
+				projectLocation(topoLocation, projection)
+				topoLocations[topoLocation.Location] = topoLocation
+				if topoLocation.Resolution == l8topo.L8TopologyLocationResolution_Unresolved {
+					unresolved[topoLocation.Location] = true
+				}
+			}
+			this.addIndoorLocations(topoNode.Location, topoLocations)
 		}
-		fldList := v.FieldByName("List")
-		if !fldList.IsValid() {
-			vnic.Resources().Logger().Error("[DiscoverNodes] Nodes List Element does not contain the List attribute:", v.Type().Name())
-			return
+		f.report.Devices = int32(len(converted))
+		f.report.UnresolvedLocations = int32(len(unresolved))
+		if len(f.elems) > 0 && len(converted) == 0 {
+			f.err = errors.New("none of the " + strconv.Itoa(len(f.elems)) + " elements could be converted to a node")
 		}
+		f.elems = converted
+	}
+	if len(merger.nodes) == 0 {
+		return nil, nil
+	}
+
+	this.Post(object.New(nil, merger.nodes), vnic)
+	this.Post(object.New(nil, topoLocations), vnic)
+	return merger.nodes, this.discoverLinks(fetched, merger, vnic)
+}
 
-		for i := 0; i < fldList.Len(); i++ {
-			item := fldList.Index(i)
-			nodes = append(nodes, item.Interface())
-			topoNode, topoLocation := this.discovery.ConvertToTopologyNode(item.Interface())
-			topoNodes = append(topoNodes, topoNode)
-			topoLocations[topoLocation.Location] = topoLocation
+// convertNode converts an inventory element to a node, a failed conversion is an error instead of a panic
+func convertNode(discovery ITopoDiscovery, elem interface{}) (node *l8topo.L8TopologyNode, location *l8topo.L8TopologyLocation, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			node, location = nil, nil
+			err = errors.New(fmt.Sprintf("failed to convert %T: %v", elem, r))
 		}
+	}()
+	node, location = discovery.ConvertToTopologyNode(elem)
+	if node == nil || node.NodeId == "" {
+		return nil, nil, errors.New(fmt.Sprintf("failed to convert %T: no node id", elem))
 	}
+	return node, location, nil
+}
 
-	this.Post(object.New(nil, topoNodes), vnic)
-	this.Post(object.New(nil, topoLocations), vnic)
-	this.discoverLinks(nodes, vnic)
+// addIndoorLocations adds the building, floor, room and rack locations of a node location path
+// that are not known yet, the known ones keep their floor plan coordinates
+func (this *TopoService) addIndoorLocations(location string, topoLocations map[string]*l8topo.L8TopologyLocation) {
+	for _, indoor := range indoorLocationsOf(location) {
+		if _, ok := topoLocations[indoor.Location]; ok || this.nodeL8Location(indoor.Location) != nil {
+			continue
+		}
+		topoLocations[indoor.Location] = indoor
+	}
 }
 
-func (this *TopoService) discoverLinks(nodes []interface{}, vnic ifs.IVNic) {
-	maps := make(map[string]map[string]interface{})
-	for _, node := range nodes {
-		nodeElems := properties.Collect(node, vnic.Resources(), this.discovery.ModelTypeName())
-		idof := this.discovery.IdOf(node)
-		maps[idof] = make(map[string]interface{})
-		for k, p := range nodeElems {
-			maps[idof][k] = p
+func (this *TopoService) discoverLinks(fetched []*sourceElements, merger *nodeMerger, vnic ifs.IVNic) []*l8topo.L8TopologyLink {
+	list := make([]*elemEntry, 0)
+	for _, f := range fetched {
+		modelTypeName := f.source.discovery.ModelTypeName()
+		// A source without a model type, e.g. a static list of devices, has nodes without ports
+		if f.err != nil || modelTypeName == "" {
+			continue
+		}
+		for _, node := range f.elems {
+			nodeElems := properties.Collect(node, vnic.Resources(), modelTypeName)
+			nodeId := merger.nodeIdOf(f.source.name, f.source.discovery.IdOf(node))
+			for elemId, elem := range nodeElems {
+				list = append(list, &elemEntry{source: f.source, report: f.report, nodeId: nodeId, elemId: elemId, elem: elem})
+			}
+			f.report.Ports += int32(len(nodeElems))
 		}
 	}
 
-	links := this.matchLinks(maps)
-	fmt.Println("number of links:", len(links))
+	links := this.matchLinks(list)
+	for _, link := range links {
+		for _, f := range fetched {
+			if hasSource(link.Sources, f.source.name) {
+				f.report.Links++
+			}
+		}
+	}
 	this.Post(object.New(nil, links), vnic)
+	return links
 }
 
 func createLink(aside, zside string, direction l8topo.L8TopologyLinkDirection) *l8topo.L8TopologyLink {
@@ -93,12 +251,7 @@ func createLink(aside, zside string, direction l8topo.L8TopologyLinkDirection) *
 	return link
 }
 
-func rootIdOf(side string, nodeIds map[string]bool) string {
-	index1 := strings.Index(side, "<")
-	index2 := strings.Index(side, ">")
-	rootID := side[index1+1 : index2]
-	index3 := strings.LastIndex(rootID, "}")
-	nodeId := rootID[index3+1:]
+func rootIdOf(nodeId string, nodeIds map[string]string) string {
 	_, ok := nodeIds[nodeId]
 	if !ok {
 		return ""
@@ -106,6 +259,35 @@ func rootIdOf(side string, nodeIds map[string]bool) string {
 	return nodeId
 }
 
+// asideNodeIdOf returns the id of the link aside node, from its endpoint,
+// or from its property id for links that were discovered without endpoints
+func asideNodeIdOf(link *l8topo.L8TopologyLink) string {
+	if link.AsideEndpoint != nil && link.AsideEndpoint.NodeId != "" {
+		return link.AsideEndpoint.NodeId
+	}
+	return nodeIdOf(link.Aside)
+}
+
+func zsideNodeIdOf(link *l8topo.L8TopologyLink) string {
+	if link.ZsideEndpoint != nil && link.ZsideEndpoint.NodeId != "" {
+		return link.ZsideEndpoint.NodeId
+	}
+	return nodeIdOf(link.Zside)
+}
+
+// nodeIdOf returns the id of the node a link side property id belongs to,
+// or an empty id if the side is not a property id
+func nodeIdOf(side string) string {
+	index1 := strings.Index(side, "<")
+	index2 := strings.Index(side, ">")
+	if index1 == -1 || index2 < index1 {
+		return ""
+	}
+	rootID := side[index1+1 : index2]
+	index3 := strings.LastIndex(rootID, "}")
+	return rootID[index3+1:]
+}
+
 func createLinkId(aSidePropertyId, zSidePropertyId string, direction l8topo.L8TopologyLinkDirection) string {
 	buff := bytes.Buffer{}
 	buff.WriteString(aSidePropertyId)
@@ -123,27 +305,83 @@ func createLinkId(aSidePropertyId, zSidePropertyId string, direction l8topo.L8To
 	return buff.String()
 }
 
-func (this *TopoService) matchLinks(maps map[string]map[string]interface{}) []*l8topo.L8TopologyLink {
-	links := make([]*l8topo.L8TopologyLink, 0)
-	alreadyConnected := make(map[string]bool)
+// elemEntry is a link side element of a node, e.g. a port, with the source that discovered it
+type elemEntry struct {
+	source *discoverySource
+	report *l8topo.L8TopologyDiscoveryReport
+	nodeId string
+	elemId string
+	elem   interface{}
+}
 
-	// Flatten the nested map into a topo_list of port entries for more efficient iteration
-	type elemEntry struct {
-		nodeId string
-		elemId string
-		elem   interface{}
+// endpointOf returns the endpoint of a link side, with the port details its discovery knows about
+func endpointOf(entry *elemEntry) *l8topo.L8TopologyLinkEndpoint {
+	var endpoint *l8topo.L8TopologyLinkEndpoint
+	endpoints, ok := entry.source.discovery.(IEndpointDiscovery)
+	if ok {
+		endpoint = endpoints.LinkEndpoint(entry.elem)
 	}
+	if endpoint == nil {
+		endpoint = &l8topo.L8TopologyLinkEndpoint{}
+	}
+	endpoint.NodeId = entry.nodeId
+	endpoint.PortId = entry.elemId
+	return endpoint
+}
 
-	list := make([]*elemEntry, 0)
-	for nodeId, elems := range maps {
-		for elemId, elem := range elems {
-			list = append(list, &elemEntry{
-				nodeId: nodeId,
-				elemId: elemId,
-				elem:   elem,
-			})
+// linkOf returns the link between two link side elements, or nil if they are not connected.
+// Only elements of the same type are compared, elements of different sources are matched by the discovery of either side.
+func linkOf(aside, zside *elemEntry) *l8topo.L8TopologyLink {
+	if reflect.TypeOf(aside.elem) != reflect.TypeOf(zside.elem) {
+		return nil
+	}
+	link := aside.source.linkOf(aside, zside)
+	if link == nil && zside.source != aside.source {
+		link = zside.source.linkOf(aside, zside)
+	}
+	return link
+}
+
+// linkOf returns the link of the source discovery between two elements, a failed match is a conversion error
+// of the source report instead of a panic
+func (this *discoverySource) linkOf(aside, zside *elemEntry) (link *l8topo.L8TopologyLink) {
+	defer func() {
+		if r := recover(); r != nil {
+			link = nil
+			report := aside.report
+			if zside.source == this {
+				report = zside.report
+			}
+			if report != nil {
+				addConversionError(report, errors.New(fmt.Sprintf("failed to match %s and %s: %v", aside.elemId, zside.elemId, r)))
+			}
 		}
+	}()
+	connected, direction := this.discovery.IsConnected(aside.elem, zside.elem)
+	if !connected {
+		return nil
+	}
+	link = createLink(aside.elemId, zside.elemId, direction)
+	details, ok := this.discovery.(ILinkDetails)
+	if ok {
+		link.Status = details.LinkStatus(aside.elem, zside.elem)
+		link.Capacity = details.LinkCapacity(aside.elem, zside.elem)
+	}
+	link.MemberCount = 1
+	attributes, ok := this.discovery.(ILinkAttributes)
+	if ok {
+		link.Attributes = attributes.LinkAttributes(aside.elem, zside.elem)
 	}
+	link.AsideEndpoint = endpointOf(aside)
+	link.ZsideEndpoint = endpointOf(zside)
+	link.Sources = sourcesOf(aside.source.name, zside.source.name)
+	return link
+}
+
+func (this *TopoService) matchLinks(list []*elemEntry) []*l8topo.L8TopologyLink {
+	links := make([]*l8topo.L8TopologyLink, 0)
+	alreadyConnected := make(map[*elemEntry]bool)
+	lagKeys := make(map[string]string)
 
 	// Iterate through port pairs more efficiently
 	// Only check each pair once (i,j where j > i) instead of both (i,j) and (j,i)
@@ -151,7 +389,7 @@ func (this *TopoService) matchLinks(maps map[string]map[string]interface{}) []*l
 		aSideEntry := list[i]
 
 		// Skip if this port is already connected - check once at outer loop
-		if alreadyConnected[aSideEntry.elemId] {
+		if alreadyConnected[aSideEntry] {
 			continue
 		}
 
@@ -159,13 +397,13 @@ func (this *TopoService) matchLinks(maps map[string]map[string]interface{}) []*l
 		for j := i + 1; j < len(list); j++ {
 			zSideEntry := list[j]
 
-			// Skip ports from the same device
+			// Skip ports from the same device, also when the device is discovered by several sources
 			if aSideEntry.nodeId == zSideEntry.nodeId {
 				continue
 			}
 
 			// Skip if Z-side port is already connected
-			if alreadyConnected[zSideEntry.elemId] {
+			if alreadyConnected[zSideEntry] {
 				continue
 			}
 
@@ -178,25 +416,20 @@ func (this *TopoService) matchLinks(maps map[string]map[string]interface{}) []*l
 				zside = aSideEntry
 			}
 
-			connected, direction := this.discovery.IsConnected(aside.elem, zside.elem)
-			if connected {
-				alreadyConnected[aside.elemId] = true
-				alreadyConnected[zside.elemId] = true
-				link := createLink(aside.elemId, zside.elemId, direction)
+			link := linkOf(aside, zside)
+			if link != nil {
+				alreadyConnected[aside] = true
+				alreadyConnected[zside] = true
 				links = append(links, link)
+				asideLag := lagOf(aside.source.discovery, aside.elem)
+				zsideLag := lagOf(zside.source.discovery, zside.elem)
+				if asideLag != "" && zsideLag != "" {
+					lagKeys[link.LinkId] = createLinkId(aside.nodeId+"."+asideLag, zside.nodeId+"."+zsideLag, l8topo.L8TopologyLinkDirection_InvalidDirection)
+				}
 				break // A-side port is now matched, move to next A-side port
 			}
 		}
 	}
 
-	return links
-}
-
-func (this *TopoService) locationOf(nodeid string) string {
-	filter := &l8topo.L8TopologyNode{NodeId: nodeid}
-	tpnode, err := this.nodes.Get(filter)
-	if err != nil {
-		panic(err)
-	}
-	return tpnode.(*l8topo.L8TopologyNode).Location
+	return bundleLags(links, lagKeys)
 }
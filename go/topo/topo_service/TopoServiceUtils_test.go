@@ -0,0 +1,19 @@
+package topo_service
+
+import "testing"
+
+func TestNodeIdOf(t *testing.T) {
+	sides := map[string]string{
+		"networkdevice<{24}R1>.physicals<{24}p1>.ports<{24}1>": "R1",
+		"networkdevice<R2>": "R2",
+		"R3":                "",
+		"networkdevice<R4":  "",
+		"networkdevice>R5<": "",
+		"":                  "",
+	}
+	for side, expected := range sides {
+		if nodeId := nodeIdOf(side); nodeId != expected {
+			t.Fatal("side", side, "expected", expected, "got", nodeId)
+		}
+	}
+}
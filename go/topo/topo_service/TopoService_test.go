@@ -0,0 +1,85 @@
+package topo_service
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/saichler/l8reflect/go/reflect/introspecting"
+	"github.com/saichler/l8topology/go/types/l8topo"
+	"github.com/saichler/l8types/go/ifs"
+	"github.com/saichler/l8utils/go/utils/cache"
+	"github.com/saichler/l8utils/go/utils/logger"
+	"github.com/saichler/l8utils/go/utils/registry"
+	res "github.com/saichler/l8utils/go/utils/resources"
+)
+
+// newTestResources returns resources with the primary keys of the topology elements
+func newTestResources() ifs.IResources {
+	resources := res.NewResources(logger.NewLoggerImpl(&logger.FmtLogMethod{}))
+	resources.Set(registry.NewRegistry())
+	resources.Set(introspecting.NewIntrospect(resources.Registry()))
+	resources.Introspector().Decorators().AddPrimaryKeyDecorator(&l8topo.L8TopologyNode{}, "NodeId")
+	resources.Introspector().Decorators().AddPrimaryKeyDecorator(&l8topo.L8TopologyLink{}, "LinkId")
+	resources.Introspector().Decorators().AddPrimaryKeyDecorator(&l8topo.L8TopologyLocation{}, "Location")
+	resources.Introspector().Decorators().AddPrimaryKeyDecorator(&l8topo.L8TopologyPinnedPosition{}, "NodeId", "Layout")
+	return resources
+}
+
+// newCachedService returns a service with the caches of the nodes, links and locations, and their index
+func newCachedService() *TopoService {
+	resources := newTestResources()
+	service := &TopoService{}
+	service.nodes = cache.NewCache(&l8topo.L8TopologyNode{}, nil, nil, resources)
+	service.links = cache.NewCache(&l8topo.L8TopologyLink{}, nil, nil, resources)
+	service.locations = cache.NewCache(&l8topo.L8TopologyLocation{}, nil, nil, resources)
+	service.index = newSpatialIndex(spatialCellSize)
+	return service
+}
+
+// newTestTopology returns an empty topology of the world map size
+func newTestTopology() *l8topo.L8Topology {
+	topology := &l8topo.L8Topology{Name: "test", Width: svgWidth, Height: svgHeight}
+	topology.Nodes = make(map[string]*l8topo.L8TopologyNode)
+	topology.Links = make(map[string]*l8topo.L8TopologyLink)
+	topology.Locations = make(map[string]*l8topo.L8TopologyLocation)
+	return topology
+}
+
+// addTestNode adds a node at a location, the location is added at x, y if the topology does not have it
+func addTestNode(topology *l8topo.L8Topology, nodeId, location string, x, y float32) *l8topo.L8TopologyNode {
+	node := &l8topo.L8TopologyNode{NodeId: nodeId, Name: nodeId, Location: location}
+	topology.Nodes[nodeId] = node
+	if _, ok := topology.Locations[location]; !ok {
+		topology.Locations[location] = &l8topo.L8TopologyLocation{Location: location, SvgX: x, SvgY: y}
+	}
+	return node
+}
+
+// addTestLink adds a bidirectional link between two nodes
+func addTestLink(topology *l8topo.L8Topology, aside, zside string) *l8topo.L8TopologyLink {
+	link := createLink(aside, zside, l8topo.L8TopologyLinkDirection_Bidirectional)
+	topology.Links[link.LinkId] = link
+	return link
+}
+
+// hasKeys returns true if the elements have exactly the keys
+func hasKeys[T any](elements map[string]T, keys []string) bool {
+	if len(elements) != len(keys) {
+		return false
+	}
+	for _, key := range keys {
+		if _, ok := elements[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// sameIds returns true if the ids are the same, in any order
+func sameIds(a, b []string) bool {
+	a = append([]string{}, a...)
+	b = append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	return slices.Equal(a, b)
+}
@@ -42,6 +42,12 @@ async function initializeApp() {
 
 // Setup WebGL event callbacks
 function setupWebGLCallbacks() {
+    // Map background of another projection
+    browser.onMapBackgroundChange = (url, size) => {
+        webglTopology.camera.setViewSize(size.width, size.height);
+        webglTopology.loadMapTexture(url);
+    };
+
     // Node click
     webglTopology.onNodeClick = (nodeId, nodeData) => {
         browser.showNodeDetails(nodeId);
@@ -97,6 +103,9 @@ function overrideBrowserMethods() {
     const originalSetLayout = browser.setLayout.bind(browser);
     browser.setLayout = function(layout) {
         this.layoutMode = layout;
+        if (layout === 'floorplan') {
+            this.selectFloorPlan();
+        }
 
         if (webglTopology) {
             webglTopology.setLayoutMode(layout);
@@ -6,6 +6,9 @@ class TopologyBrowser {
         this.selectedTopologyName = null;
         this.mapWidth = 0;
         this.mapHeight = 0;
+        // The map background, of a projection or a floor plan, and the size it is calibrated to
+        this.mapBackground = 'resources/world.svg';
+        this.mapSize = { width: 2000, height: 857 };
         this.apiBaseUrl = '/probler';
 
         // Link Direction Enum
@@ -54,6 +57,12 @@ class TopologyBrowser {
         // Layout mode: 'map' or 'hierarchical'
         this.layoutMode = 'map';
 
+        // Heatmap colors the links by utilization instead of status
+        this.heatmap = false;
+
+        // Route the links of the non-map layouts around the nodes, as orthogonal polylines
+        this.routeEdges = false;
+
         this.init();
     }
 
@@ -98,6 +107,34 @@ class TopologyBrowser {
         const layoutSelect = document.getElementById('layout-select');
         layoutSelect.addEventListener('change', (e) => this.setLayout(e.target.value));
 
+        // Heatmap toggle
+        const heatmapBtn = document.getElementById('heatmap-btn');
+        heatmapBtn.addEventListener('click', () => {
+            this.heatmap = !this.heatmap;
+            heatmapBtn.classList.toggle('active', this.heatmap);
+            if (this.selectedTopologyName) {
+                if (this.canvasSelection) {
+                    this.loadTopologyWithCanvas(this.selectedTopologyName);
+                } else {
+                    this.loadTopology(this.selectedTopologyName);
+                }
+            }
+        });
+
+        // Edge routing toggle
+        const routeEdgesBtn = document.getElementById('route-edges-btn');
+        routeEdgesBtn.addEventListener('click', () => {
+            this.routeEdges = !this.routeEdges;
+            routeEdgesBtn.classList.toggle('active', this.routeEdges);
+            if (this.selectedTopologyName && this.layoutMode !== 'map' && this.layoutMode !== 'floorplan') {
+                if (this.canvasSelection) {
+                    this.loadTopologyWithCanvas(this.selectedTopologyName);
+                } else {
+                    this.loadTopology(this.selectedTopologyName);
+                }
+            }
+        });
+
         // Mouse wheel zoom
         mapContainer.addEventListener('wheel', (e) => {
             e.preventDefault();
@@ -160,8 +197,8 @@ class TopologyBrowser {
         });
 
         worldMap.addEventListener('load', () => {
-            this.mapWidth = 2000;
-            this.mapHeight = 857;
+            this.mapWidth = this.mapSize.width;
+            this.mapHeight = this.mapSize.height;
             this.syncOverlayWithMap();
 
             if (this.currentTopology) {
@@ -171,8 +208,8 @@ class TopologyBrowser {
 
         // Handle case where image is already loaded (cached)
         if (worldMap.complete) {
-            this.mapWidth = 2000;
-            this.mapHeight = 857;
+            this.mapWidth = this.mapSize.width;
+            this.mapHeight = this.mapSize.height;
             this.syncOverlayWithMap();
         }
 
@@ -502,13 +539,25 @@ class TopologyBrowser {
         this.setStatus('Canvas selection cleared');
     }
 
+    // selectFloorPlan asks for the building, floor or room location whose floor plan is shown
+    selectFloorPlan() {
+        const floorPlan = window.prompt('Floor plan location (e.g. "London, United Kingdom / LON-DC2 / Floor 1")', this.floorPlan || '');
+        if (floorPlan !== null) {
+            this.floorPlan = floorPlan.trim();
+        }
+    }
+
     setLayout(layout) {
         const worldMap = document.getElementById('world-map');
 
         this.layoutMode = layout;
 
+        if (layout === 'floorplan') {
+            this.selectFloorPlan();
+        }
+
         // Show/hide world map based on layout
-        if (layout === 'map') {
+        if (layout === 'map' || layout === 'floorplan') {
             worldMap.style.display = 'block';
         } else {
             worldMap.style.display = 'none';
@@ -33,8 +33,9 @@ TopologyBrowser.prototype.loadTopologyList = async function() {
         }
 
         const data = await response.json();
-        // Response is L8TopologyMetadataList with 'list' array of L8TopologyMetadata objects
-        this.topologyMetadataList = data.list || [];
+        // Response is L8TopologyMetadataList with 'list' array of L8TopologyMetadata objects,
+        // topologies whose last discovery failed are not offered
+        this.topologyMetadataList = (data.list || []).filter(item => item.status !== 'TopologyFailed');
         this.topologies = this.topologyMetadataList.map(item => item.name);
         this.populateTopologySelect();
         this.setStatus('Topology list loaded', 'success');
@@ -56,25 +57,50 @@ TopologyBrowser.prototype.populateTopologySelect = function() {
         const option = document.createElement('option');
         option.value = topology;
         option.textContent = topology;
+        const metadata = this.topologyMetadataList.find(item => item.name === topology);
+        if (metadata) {
+            option.title = this.topologyStatusOf(metadata);
+            if (metadata.status === 'TopologyDiscovering') {
+                option.textContent = `${topology} (discovering)`;
+            }
+        }
         select.appendChild(option);
     });
 };
 
+// Summary of a topology list entry, its description, size and last discovery time
+TopologyBrowser.prototype.topologyStatusOf = function(metadata) {
+    const lines = [];
+    if (metadata.description) {
+        lines.push(metadata.description);
+    }
+    lines.push(`${metadata.nodeCount || 0} nodes, ${metadata.linkCount || 0} links`);
+    if (metadata.lastDiscovery) {
+        lines.push(`Discovered ${new Date(Number(metadata.lastDiscovery)).toLocaleString()}`);
+    }
+    return lines.join('\n');
+};
+
 TopologyBrowser.prototype.loadTopology = async function(name) {
     this.setStatus(`Loading topology: ${name}...`);
     this.resetPagination();
+    // Reloading the same topology with the same query asks only for the changes since its revision,
+    // another layout or query is another view of the topology
+    const queryKey = this.topologyQueryKey(name);
+    const previous = this.currentTopology && this.currentTopology.queryKey === queryKey ? this.currentTopology : null;
     this.selectedTopologyName = name;
 
     try {
         // Find metadata for this topology to get serviceName and serviceArea
         const metadata = this.topologyMetadataList.find(item => item.name === name);
-        const endpoint = this.topologyNameToEndpoint(name, metadata);
+        const endpoint = this.topologyNameToEndpoint(name, metadata, null, previous ? previous.revision : null);
         const response = await fetch(endpoint);
         if (!response.ok) {
             throw new Error(`HTTP error! status: ${response.status}`);
         }
 
-        this.currentTopology = await response.json();
+        this.currentTopology = this.applyTopologyDelta(previous, await response.json());
+        this.currentTopology.queryKey = queryKey;
         this.renderTopology();
         this.setStatus(`Topology "${name}" loaded successfully`, 'success');
     } catch (error) {
@@ -86,10 +112,15 @@ TopologyBrowser.prototype.loadTopology = async function(name) {
     }
 };
 
-TopologyBrowser.prototype.topologyNameToEndpoint = function(name, metadata, canvasSelection) {
+// topologyQueryKey identifies the view that loadTopology requests, a revision applies only to the same view
+TopologyBrowser.prototype.topologyQueryKey = function(name) {
+    return JSON.stringify([name, this.layoutMode, !!this.heatmap, this.floorPlan || '', !!this.routeEdges]);
+};
+
+TopologyBrowser.prototype.topologyNameToEndpoint = function(name, metadata, canvasSelection, revision) {
     // Map layout mode to layout enum value
-    // 0=Location, 1=Hierarchical, 2=Circular, 3=Radial, 4=Force_Directed
-    const layoutMap = { 'map': 0, 'hierarchical': 1, 'circular': 2, 'radial': 3, 'force': 4 };
+    // 0=Location, 1=Hierarchical, 2=Circular, 3=Radial, 4=Force_Directed, 5=Floor_Plan
+    const layoutMap = { 'map': 0, 'hierarchical': 1, 'circular': 2, 'radial': 3, 'force': 4, 'floorplan': 5 };
     const layout = layoutMap[this.layoutMode] || 0;
 
     // Build body with layout and canvas selection if available
@@ -100,6 +131,18 @@ TopologyBrowser.prototype.topologyNameToEndpoint = function(name, metadata, canv
         x1: canvasSelection ? canvasSelection.x1 : 0,
         y1: canvasSelection ? canvasSelection.y1 : 0
     };
+    if (revision) {
+        bodyObj.revision = revision;
+    }
+    if (this.heatmap) {
+        bodyObj.heatmap = true;
+    }
+    if (this.layoutMode === 'floorplan') {
+        bodyObj.floorPlan = this.floorPlan;
+    }
+    if (this.routeEdges && this.layoutMode !== 'map' && this.layoutMode !== 'floorplan') {
+        bodyObj.routeEdges = true;
+    }
     const body = encodeURIComponent(JSON.stringify(bodyObj));
 
     // Use metadata if available (serviceName and serviceArea)
@@ -116,6 +159,55 @@ TopologyBrowser.prototype.topologyNameToEndpoint = function(name, metadata, canv
     return `${this.apiBaseUrl}/1/${name}?body=${body}`;
 };
 
+// loadCrossLayer shows the elements of another topology layer that map to links or nodes of the current topology,
+// e.g. the physical links that carry an L3 adjacency
+TopologyBrowser.prototype.loadCrossLayer = async function(layerName, linkIds, nodeIds) {
+    const source = this.topologyMetadataList.find(item => item.name === this.selectedTopologyName);
+    const layer = this.topologyMetadataList.find(item => item.name === layerName);
+    if (!source || !layer) return;
+    this.setStatus(`Mapping to ${layerName}...`);
+    try {
+        const body = encodeURIComponent(JSON.stringify({
+            mapServiceName: layer.serviceName,
+            mapServiceArea: layer.serviceArea,
+            mapLinkIds: linkIds,
+            mapNodeIds: nodeIds
+        }));
+        const response = await fetch(`${this.apiBaseUrl}/${source.serviceArea}/${source.serviceName}?body=${body}`);
+        if (!response.ok) {
+            throw new Error(`HTTP error! status: ${response.status}`);
+        }
+        this.currentTopology = await response.json();
+        this.renderTopology();
+        const links = Object.keys(this.currentTopology.links || {}).length;
+        const nodes = Object.keys(this.currentTopology.nodes || {}).length;
+        this.setStatus(`${layerName}: ${links} links and ${nodes} nodes map to the selection, refresh to return`, 'success');
+    } catch (error) {
+        console.error('Error mapping to another layer:', error);
+        this.setStatus(`Error mapping to ${layerName}: ${error.message}`, 'error');
+    }
+};
+
+// applyTopologyDelta merges a delta response into the topology of its revision,
+// a full response replaces it
+TopologyBrowser.prototype.applyTopologyDelta = function(current, response) {
+    if (!response.delta || !current) {
+        return response;
+    }
+    ['nodes', 'links', 'locations'].forEach(key => {
+        current[key] = Object.assign(current[key] || {}, response[key] || {});
+    });
+    (response.removedNodes || []).forEach(id => delete current.nodes[id]);
+    (response.removedLinks || []).forEach(id => delete current.links[id]);
+    (response.removedLocations || []).forEach(id => delete current.locations[id]);
+    current.revision = response.revision;
+    current.width = response.width;
+    current.height = response.height;
+    current.projection = response.projection;
+    current.floorPlanImage = response.floorPlanImage;
+    return current;
+};
+
 TopologyBrowser.prototype.loadTopologyWithCanvas = async function(name) {
     this.setStatus(`Loading topology with canvas selection: ${name}...`);
     this.resetPagination();
@@ -224,12 +316,44 @@ TopologyBrowser.prototype.renderTopology = function() {
         return;
     }
 
+    this.updateMapBackground(this.currentTopology);
     this.updateTopologyInfo();
     this.updateNodesList();
     this.updateLinksList();
     this.renderMap();
 };
 
+// mapBackgroundOf returns the map background of a projection, the shipped world map of the Robinson projection,
+// or the background the deployment provides for the other projections, e.g. resources/lcc-europe.svg
+TopologyBrowser.prototype.mapBackgroundOf = function(projection) {
+    if (!projection || projection === 'robinson') {
+        return 'resources/world.svg';
+    }
+    return `resources/${projection}.svg`;
+};
+
+// updateMapBackground switches the map background when the topology is of another projection,
+// or to the floor plan image of a floor plan topology
+TopologyBrowser.prototype.updateMapBackground = function(topology) {
+    let background;
+    if (this.layoutMode === 'map') {
+        background = this.mapBackgroundOf(topology.projection);
+    } else if (this.layoutMode === 'floorplan') {
+        background = topology.floorPlanImage || '';
+    } else {
+        return;
+    }
+    if (background === this.mapBackground) {
+        return;
+    }
+    this.mapBackground = background;
+    this.mapSize = { width: topology.width, height: topology.height };
+    document.getElementById('world-map').src = background;
+    if (this.onMapBackgroundChange) {
+        this.onMapBackgroundChange(background, this.mapSize);
+    }
+};
+
 TopologyBrowser.prototype.updateTopologyInfo = function() {
     const nameEl = document.getElementById('topology-name');
     const infoDiv = document.getElementById('topology-info');
@@ -319,15 +319,29 @@ TopologyBrowser.prototype.renderMap = function() {
     const links = this.currentTopology.links || {};
     const locations = this.currentTopology.locations || {};
 
-    // Build node positions from locations map using server-side SVG coordinates
+    // Build node positions from locations map using server-side SVG coordinates.
+    // Markers pushed apart by the server are drawn at their display position,
+    // with a leader line back to their geographic anchor.
     const nodePositions = {};
+    const anchorPositions = {};
     Object.entries(nodes).forEach(([locationKey, node]) => {
         const location = locations[node.location];
         if (location) {
             const pos = (location.svgX !== undefined && location.svgY !== undefined)
                 ? { x: location.svgX, y: location.svgY }
                 : { x: NULL_ISLAND_X, y: NULL_ISLAND_Y };
-            nodePositions[node.nodeId] = pos;
+            anchorPositions[node.nodeId] = pos;
+            nodePositions[node.nodeId] = (location.displayX !== undefined && location.displayY !== undefined)
+                ? { x: location.displayX, y: location.displayY }
+                : pos;
+        }
+    });
+
+    // Draw leader lines
+    Object.entries(nodePositions).forEach(([nodeId, pos]) => {
+        const anchor = anchorPositions[nodeId];
+        if (anchor.x !== pos.x || anchor.y !== pos.y) {
+            this.drawLeaderLine(overlaySvg, anchor, pos);
         }
     });
 
@@ -374,24 +388,58 @@ TopologyBrowser.prototype.findNodeByLink = function(linkRef, nodes) {
     return nodes[linkRef];
 };
 
-TopologyBrowser.prototype.drawLink = function(svg, link, asidePos, zsidePos) {
+TopologyBrowser.prototype.drawLeaderLine = function(svg, anchor, pos) {
     const line = document.createElementNS('http://www.w3.org/2000/svg', 'line');
+    line.setAttribute('class', 'leader-line');
+    line.setAttribute('x1', anchor.x);
+    line.setAttribute('y1', anchor.y);
+    line.setAttribute('x2', pos.x);
+    line.setAttribute('y2', pos.y);
+
+    const dot = document.createElementNS('http://www.w3.org/2000/svg', 'circle');
+    dot.setAttribute('class', 'leader-anchor');
+    dot.setAttribute('cx', anchor.x);
+    dot.setAttribute('cy', anchor.y);
+    dot.setAttribute('r', 2);
+
+    svg.appendChild(line);
+    svg.appendChild(dot);
+};
+
+TopologyBrowser.prototype.drawLink = function(svg, link, asidePos, zsidePos) {
+    // A routed link is a polyline through the bend points of its route
+    const bends = link.bendPoints || [];
+    const line = document.createElementNS('http://www.w3.org/2000/svg', bends.length > 0 ? 'polyline' : 'line');
 
     // Set class based on direction and status (default to 0 if undefined)
     const direction = link.direction ?? 0;
     const status = link.status ?? 0;
     const directionClass = `direction-${direction}`;
     const statusClass = `status-${status}`;
-    line.setAttribute('class', `link ${directionClass} ${statusClass}`);
+    const utilizationClass = link.utilizationLevel ? ` utilization-${link.utilizationLevel}` : '';
+    line.setAttribute('class', `link ${directionClass} ${statusClass}${utilizationClass}`);
 
-    line.setAttribute('x1', asidePos.x);
-    line.setAttribute('y1', asidePos.y);
-    line.setAttribute('x2', zsidePos.x);
-    line.setAttribute('y2', zsidePos.y);
+    if (bends.length > 0) {
+        const points = [asidePos, ...bends.map(bend => ({ x: bend.x ?? 0, y: bend.y ?? 0 })), zsidePos];
+        line.setAttribute('points', points.map(point => `${point.x},${point.y}`).join(' '));
+    } else {
+        line.setAttribute('x1', asidePos.x);
+        line.setAttribute('y1', asidePos.y);
+        line.setAttribute('x2', zsidePos.x);
+        line.setAttribute('y2', zsidePos.y);
+    }
     line.setAttribute('data-link-id', link.linkId);
     line.style.pointerEvents = 'stroke';
     line.style.cursor = 'pointer';
 
+    // Hover shows the link ports, speed and utilization
+    const summary = this.getLinkSummary(link);
+    if (summary) {
+        const title = document.createElementNS('http://www.w3.org/2000/svg', 'title');
+        title.textContent = summary;
+        line.appendChild(title);
+    }
+
     // Set arrow markers based on direction
     switch(direction) {
         case this.LinkDirection.ASIDE_TO_ZSIDE:
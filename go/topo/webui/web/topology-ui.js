@@ -276,6 +276,10 @@ TopologyBrowser.prototype.showNodeDetails = function(nodeId) {
                 <span class="link-detail-label">Longitude</span>
                 <span class="link-detail-value">${location?.longitude ?? 'N/A'}</span>
             </div>
+            <div class="link-detail-row">
+                <span class="link-detail-label">Sources</span>
+                <span class="link-detail-value">${(node.sources || []).join(', ') || 'N/A'}</span>
+            </div>
         </div>
     `;
 
@@ -330,7 +334,9 @@ TopologyBrowser.prototype.showNodeDetails = function(nodeId) {
     }
 
     html += '</div>';
+    html += this.getCrossLayerHtml();
     modalBody.innerHTML = html;
+    this.bindCrossLayerButtons(modalBody, [], node.memberIds && node.memberIds.length ? node.memberIds : [node.nodeId]);
 
     // Show modal
     modal.classList.add('active');
@@ -371,7 +377,7 @@ TopologyBrowser.prototype.showLinkDetails = function(linkId) {
             </div>
             <div class="link-detail-row">
                 <span class="link-detail-label">A-Side Port</span>
-                <span class="link-detail-value" style="word-break: break-all;">${link.aside}</span>
+                <span class="link-detail-value" style="word-break: break-all;">${this.getEndpointText(link.asideEndpoint, link.aside)}</span>
             </div>
             <div class="link-detail-row">
                 <span class="link-detail-label">Z-Side Node</span>
@@ -379,7 +385,7 @@ TopologyBrowser.prototype.showLinkDetails = function(linkId) {
             </div>
             <div class="link-detail-row">
                 <span class="link-detail-label">Z-Side Port</span>
-                <span class="link-detail-value" style="word-break: break-all;">${link.zside}</span>
+                <span class="link-detail-value" style="word-break: break-all;">${this.getEndpointText(link.zsideEndpoint, link.zside)}</span>
             </div>
             <div class="link-detail-row">
                 <span class="link-detail-label">Direction</span>
@@ -389,10 +395,16 @@ TopologyBrowser.prototype.showLinkDetails = function(linkId) {
                 <span class="link-detail-label">Status</span>
                 <span class="link-detail-value ${this.getStatusClass(link.status)}">${this.getStatusText(link.status)}</span>
             </div>
+            <div class="link-detail-row">
+                <span class="link-detail-label">Sources</span>
+                <span class="link-detail-value">${(link.sources || []).join(', ') || 'N/A'}</span>
+            </div>
         </div>
     `;
 
+    html += this.getCrossLayerHtml();
     modalBody.innerHTML = html;
+    this.bindCrossLayerButtons(modalBody, link.memberLinkIds && link.memberLinkIds.length ? link.memberLinkIds : [link.linkId], []);
 
     // Show modal
     modal.classList.add('active');
@@ -401,6 +413,78 @@ TopologyBrowser.prototype.showLinkDetails = function(linkId) {
     this.highlightLink(linkId);
 };
 
+// getCrossLayerHtml returns the buttons that show what an element maps to in the other topology layers
+TopologyBrowser.prototype.getCrossLayerHtml = function() {
+    const layers = (this.topologyMetadataList || []).filter(item => item.name !== this.selectedTopologyName);
+    if (layers.length === 0) return '';
+    const buttons = layers.map(item => `<button class="cross-layer-btn" data-layer="${item.name}">Show in ${item.name}</button>`);
+    return `
+        <div class="link-detail-section">
+            <h4>Other Layers</h4>
+            ${buttons.join(' ')}
+        </div>
+    `;
+};
+
+TopologyBrowser.prototype.bindCrossLayerButtons = function(container, linkIds, nodeIds) {
+    container.querySelectorAll('.cross-layer-btn').forEach(button => {
+        button.addEventListener('click', () => {
+            this.closeModal();
+            this.loadCrossLayer(button.getAttribute('data-layer'), linkIds, nodeIds);
+        });
+    });
+};
+
+// getEndpointPortName returns the interface or port name of a link endpoint
+TopologyBrowser.prototype.getEndpointPortName = function(endpoint) {
+    if (!endpoint) return '';
+    return endpoint.interfaceName || endpoint.portName || '';
+};
+
+// getEndpointText describes a link endpoint, e.g. "TenGigE0/0/0/3 @ 10G, MTU 9000, 63% in, 12% out"
+TopologyBrowser.prototype.getEndpointText = function(endpoint, fallback) {
+    const name = this.getEndpointPortName(endpoint);
+    if (!name) return fallback;
+    const details = [];
+    if (endpoint.mtu) details.push(`MTU ${endpoint.mtu}`);
+    details.push(...this.getUtilizationText(endpoint));
+    const speed = endpoint.speed ? ` @ ${this.formatSpeed(endpoint.speed)}` : '';
+    return details.length ? `${name}${speed}, ${details.join(', ')}` : `${name}${speed}`;
+};
+
+TopologyBrowser.prototype.getUtilizationText = function(endpoint) {
+    const utilization = [];
+    if (endpoint.inUtilization !== undefined) utilization.push(`${Math.round(endpoint.inUtilization)}% in`);
+    if (endpoint.outUtilization !== undefined) utilization.push(`${Math.round(endpoint.outUtilization)}% out`);
+    return utilization;
+};
+
+// getLinkSummary returns the hover text of a link, e.g. "TenGigE0/0/0/3 ↔ ge-0/0/41 @ 10G, 63% in"
+TopologyBrowser.prototype.getLinkSummary = function(link) {
+    const aside = this.getEndpointPortName(link.asideEndpoint);
+    const zside = this.getEndpointPortName(link.zsideEndpoint);
+    if (!aside && !zside) return '';
+    let summary = `${aside || '?'} \u2194 ${zside || '?'}`;
+    const speed = link.asideEndpoint?.speed || link.zsideEndpoint?.speed;
+    if (speed) summary += ` @ ${this.formatSpeed(speed)}`;
+    if (link.asideEndpoint) {
+        const utilization = this.getUtilizationText(link.asideEndpoint);
+        if (utilization.length) summary += `, ${utilization.join(', ')}`;
+    }
+    return summary;
+};
+
+// formatSpeed formats a speed in bits per second, e.g. 10000000000 as "10G"
+TopologyBrowser.prototype.formatSpeed = function(speed) {
+    // 64 bit speeds arrive as strings in JSON
+    speed = Number(speed);
+    const units = [[1e12, 'T'], [1e9, 'G'], [1e6, 'M'], [1e3, 'K']];
+    for (const [size, unit] of units) {
+        if (speed >= size) return `${+(speed / size).toFixed(1)}${unit}`;
+    }
+    return `${speed}`;
+};
+
 TopologyBrowser.prototype.closeModal = function() {
     const modal = document.getElementById('link-modal');
     modal.classList.remove('active');
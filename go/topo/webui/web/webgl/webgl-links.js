@@ -1,5 +1,5 @@
 // Link Renderer for WebGL Topology
-// Renders links as thick lines with direction arrows
+// Renders links as thick lines with direction arrows, a routed link is a polyline through its bend points
 
 class WebGLLinkRenderer {
     constructor(renderer) {
@@ -15,6 +15,7 @@ class WebGLLinkRenderer {
         this.arrowBuffer = null;
         this.lineData = null;
         this.arrowData = null;
+        this.lineVertexCount = 0;
 
         // Constants
         this.LINE_VERTEX_SIZE = 6; // x, y, r, g, b, a
@@ -29,6 +30,14 @@ class WebGLLinkRenderer {
             3: [1.0, 0.757, 0.027, 0.7]    // Partial - yellow
         };
 
+        // Heatmap colors for the utilization levels, used instead of the status colors when set
+        this.utilizationColors = {
+            1: [0.145, 0.388, 0.922, 0.7], // Low - blue
+            2: [0.133, 0.773, 0.369, 0.7], // Medium - green
+            3: [0.976, 0.451, 0.086, 0.7], // High - orange
+            4: [0.863, 0.149, 0.149, 0.7]  // Critical - red
+        };
+
         // Direction enum
         this.Direction = {
             INVALID: 0,
@@ -62,11 +71,13 @@ class WebGLLinkRenderer {
                     y1: asidePos.y,
                     x2: zsidePos.x,
                     y2: zsidePos.y,
+                    points: this.pointsOf(link, asidePos, zsidePos),
                     direction: link.direction ?? 0,
                     status: status,
-                    color: [...this.statusColors[status]],
+                    utilizationLevel: link.utilizationLevel ?? 0,
                     selected: false
                 };
+                linkData.color = this.colorOf(linkData);
                 this.links.push(linkData);
                 this.linkMap.set(link.linkId, index);
                 index++;
@@ -76,6 +87,22 @@ class WebGLLinkRenderer {
         this.buildBuffers();
     }
 
+    // Points of a link polyline, from the aside through the bend points of its route to the zside
+    pointsOf(link, asidePos, zsidePos) {
+        const points = [{ x: asidePos.x, y: asidePos.y }];
+        (link.bendPoints || []).forEach(bend => {
+            points.push({ x: bend.x ?? 0, y: bend.y ?? 0 });
+        });
+        points.push({ x: zsidePos.x, y: zsidePos.y });
+        return points;
+    }
+
+    // Color of a link, by its utilization level on the heatmap or by its status
+    colorOf(link) {
+        const color = this.utilizationColors[link.utilizationLevel] || this.statusColors[link.status];
+        return [...color];
+    }
+
     // Find node position from link reference
     findNodePosition(linkRef, nodePositions) {
         const match = linkRef.match(/networkdevice<\{24\}\{24\}(\w+)\>/);
@@ -96,8 +123,13 @@ class WebGLLinkRenderer {
             return;
         }
 
-        // Build line data (2 vertices per line, 6 floats per vertex)
-        this.lineData = new Float32Array(linkCount * 2 * this.LINE_VERTEX_SIZE);
+        // Build line data (2 vertices per segment, 6 floats per vertex)
+        this.lineVertexCount = 0;
+        this.links.forEach(link => {
+            link.vertexOffset = this.lineVertexCount;
+            this.lineVertexCount += (link.points.length - 1) * 2;
+        });
+        this.lineData = new Float32Array(this.lineVertexCount * this.LINE_VERTEX_SIZE);
 
         // Build arrow data (3 vertices per arrow, multiple arrows per link)
         const arrowVertices = [];
@@ -124,46 +156,41 @@ class WebGLLinkRenderer {
         gl.bufferData(gl.ARRAY_BUFFER, this.arrowData, gl.STATIC_DRAW);
     }
 
-    // Update line data for a single link
+    // Update line data for a single link, one line per segment of its polyline
     updateLinkLine(index, link) {
-        const offset = index * 2 * this.LINE_VERTEX_SIZE;
-
-        // Vertex 1 (start)
-        this.lineData[offset + 0] = link.x1;
-        this.lineData[offset + 1] = link.y1;
-        this.lineData[offset + 2] = link.color[0];
-        this.lineData[offset + 3] = link.color[1];
-        this.lineData[offset + 4] = link.color[2];
-        this.lineData[offset + 5] = link.color[3];
-
-        // Vertex 2 (end)
-        this.lineData[offset + 6] = link.x2;
-        this.lineData[offset + 7] = link.y2;
-        this.lineData[offset + 8] = link.color[0];
-        this.lineData[offset + 9] = link.color[1];
-        this.lineData[offset + 10] = link.color[2];
-        this.lineData[offset + 11] = link.color[3];
+        let offset = link.vertexOffset * this.LINE_VERTEX_SIZE;
+        for (let i = 0; i < link.points.length - 1; i++) {
+            [link.points[i], link.points[i + 1]].forEach(point => {
+                this.lineData[offset + 0] = point.x;
+                this.lineData[offset + 1] = point.y;
+                this.lineData[offset + 2] = link.color[0];
+                this.lineData[offset + 3] = link.color[1];
+                this.lineData[offset + 4] = link.color[2];
+                this.lineData[offset + 5] = link.color[3];
+                offset += this.LINE_VERTEX_SIZE;
+            });
+        }
     }
 
-    // Build arrow vertices for a link
-    buildArrowVertices(link, vertices) {
-        const dx = link.x2 - link.x1;
-        const dy = link.y2 - link.y1;
+    // Unit direction of a segment, null if its points are too close
+    directionOf(from, to) {
+        const dx = to.x - from.x;
+        const dy = to.y - from.y;
         const len = Math.sqrt(dx * dx + dy * dy);
+        if (len < 1) return null;
+        return { x: dx / len, y: dy / len };
+    }
 
-        if (len < 1) return;
-
-        const nx = dx / len;
-        const ny = dy / len;
-
-        // Perpendicular vector
-        const px = -ny;
-        const py = nx;
-
+    // Build arrow vertices for a link, on its last segment toward the zside and its first segment toward the aside
+    buildArrowVertices(link, vertices) {
+        const points = link.points;
         const arrowLen = this.arrowSize;
         const arrowWidth = this.arrowSize * 0.6;
 
         const addArrow = (tipX, tipY, dirX, dirY) => {
+            // Perpendicular vector
+            const px = -dirY;
+            const py = dirX;
             // Arrow tip
             vertices.push(tipX, tipY, link.color[0], link.color[1], link.color[2], link.color[3]);
             // Arrow base left
@@ -181,21 +208,23 @@ class WebGLLinkRenderer {
         };
 
         // Arrow at end (A->Z direction)
-        if (link.direction === this.Direction.ASIDE_TO_ZSIDE ||
-            link.direction === this.Direction.BIDIRECTIONAL) {
+        const last = this.directionOf(points[points.length - 2], points[points.length - 1]);
+        if (last && (link.direction === this.Direction.ASIDE_TO_ZSIDE ||
+            link.direction === this.Direction.BIDIRECTIONAL)) {
             // Offset arrow from endpoint
-            const tipX = link.x2 - nx * 10;
-            const tipY = link.y2 - ny * 10;
-            addArrow(tipX, tipY, nx, ny);
+            const tipX = link.x2 - last.x * 10;
+            const tipY = link.y2 - last.y * 10;
+            addArrow(tipX, tipY, last.x, last.y);
         }
 
         // Arrow at start (Z->A direction)
-        if (link.direction === this.Direction.ZSIDE_TO_ASIDE ||
-            link.direction === this.Direction.BIDIRECTIONAL) {
+        const first = this.directionOf(points[0], points[1]);
+        if (first && (link.direction === this.Direction.ZSIDE_TO_ASIDE ||
+            link.direction === this.Direction.BIDIRECTIONAL)) {
             // Offset arrow from start point, pointing back
-            const tipX = link.x1 + nx * 10;
-            const tipY = link.y1 + ny * 10;
-            addArrow(tipX, tipY, -nx, -ny);
+            const tipX = link.x1 + first.x * 10;
+            const tipY = link.y1 + first.y * 10;
+            addArrow(tipX, tipY, -first.x, -first.y);
         }
     }
 
@@ -234,7 +263,7 @@ class WebGLLinkRenderer {
         // Set line width (note: may be limited by GPU)
         gl.lineWidth(this.lineWidth);
 
-        gl.drawArrays(gl.LINES, 0, this.links.length * 2);
+        gl.drawArrays(gl.LINES, 0, this.lineVertexCount);
 
         gl.disableVertexAttribArray(locs.attributes.a_position);
         gl.disableVertexAttribArray(locs.attributes.a_color);
@@ -276,7 +305,7 @@ class WebGLLinkRenderer {
             if (prevIndex !== undefined) {
                 const link = this.links[prevIndex];
                 link.selected = false;
-                link.color = [...this.statusColors[link.status]];
+                link.color = this.colorOf(link);
                 this.updateLinkLine(prevIndex, link);
             }
         }
@@ -304,7 +333,7 @@ class WebGLLinkRenderer {
             const prevIndex = this.linkMap.get(this.hoveredLinkId);
             if (prevIndex !== undefined) {
                 const link = this.links[prevIndex];
-                link.color = [...this.statusColors[link.status]];
+                link.color = this.colorOf(link);
                 this.updateLinkLine(prevIndex, link);
             }
         }
@@ -334,10 +363,14 @@ class WebGLLinkRenderer {
     findLinkAt(worldX, worldY, threshold = 5) {
         for (let i = this.links.length - 1; i >= 0; i--) {
             const link = this.links[i];
-            const dist = this.pointToLineDistance(worldX, worldY, link.x1, link.y1, link.x2, link.y2);
-
-            if (dist <= threshold) {
-                return link;
+            for (let j = 0; j < link.points.length - 1; j++) {
+                const from = link.points[j];
+                const to = link.points[j + 1];
+                const dist = this.pointToLineDistance(worldX, worldY, from.x, from.y, to.x, to.y);
+
+                if (dist <= threshold) {
+                    return link;
+                }
             }
         }
         return null;
@@ -393,7 +426,7 @@ class WebGLLinkRenderer {
     // Reset all highlights
     resetHighlight() {
         this.links.forEach((link, i) => {
-            link.color = [...this.statusColors[link.status]];
+            link.color = this.colorOf(link);
             if (link.id === this.selectedLinkId) {
                 link.selected = true;
                 link.color[3] = 1.0;
@@ -451,6 +484,7 @@ class WebGLLinkRenderer {
 
         this.lineData = null;
         this.arrowData = null;
+        this.lineVertexCount = 0;
         this.links = [];
         this.linkMap.clear();
     }
@@ -178,8 +178,8 @@ class WebGLTopology {
     setLayoutMode(mode) {
         this.layoutMode = mode;
 
-        // Update background visibility
-        if (mode === 'map') {
+        // Update background visibility, a floor plan is drawn as the map background
+        if (mode === 'map' || mode === 'floorplan') {
             this.renderer.mapOpacity = 1.0;
             this.renderer.backgroundColor = [0.94, 0.97, 1.0, 1.0];
         } else {
@@ -459,8 +459,8 @@ class WebGLTopology {
         this.camera.setCanvasSize(this.canvas.width, this.canvas.height);
         this.renderer.clear();
 
-        // Draw map background (only in map mode)
-        if (this.layoutMode === 'map' && this.mapTexture) {
+        // Draw map background (only in map and floor plan modes)
+        if ((this.layoutMode === 'map' || this.layoutMode === 'floorplan') && this.mapTexture) {
             this.drawMap();
         }
 
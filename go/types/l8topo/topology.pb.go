@@ -28,6 +28,7 @@ const (
 	L8TopologyLayout_Circular       L8TopologyLayout = 2
 	L8TopologyLayout_Radial         L8TopologyLayout = 3
 	L8TopologyLayout_Force_Directed L8TopologyLayout = 4
+	L8TopologyLayout_Floor_Plan     L8TopologyLayout = 5
 )
 
 // Enum value maps for L8TopologyLayout.
@@ -38,6 +39,7 @@ var (
 		2: "Circular",
 		3: "Radial",
 		4: "Force_Directed",
+		5: "Floor_Plan",
 	}
 	L8TopologyLayout_value = map[string]int32{
 		"Location":       0,
@@ -45,6 +47,7 @@ var (
 		"Circular":       2,
 		"Radial":         3,
 		"Force_Directed": 4,
+		"Floor_Plan":     5,
 	}
 )
 
@@ -75,6 +78,67 @@ func (L8TopologyLayout) EnumDescriptor() ([]byte, []int) {
 	return file_topology_proto_rawDescGZIP(), []int{0}
 }
 
+type L8TopologyAggregation int32
+
+const (
+	L8TopologyAggregation_DefaultAggregation L8TopologyAggregation = 0
+	L8TopologyAggregation_Device             L8TopologyAggregation = 1
+	L8TopologyAggregation_Site               L8TopologyAggregation = 2
+	L8TopologyAggregation_City               L8TopologyAggregation = 3
+	L8TopologyAggregation_Country            L8TopologyAggregation = 4
+	L8TopologyAggregation_Region             L8TopologyAggregation = 5
+	L8TopologyAggregation_Attribute          L8TopologyAggregation = 6
+)
+
+// Enum value maps for L8TopologyAggregation.
+var (
+	L8TopologyAggregation_name = map[int32]string{
+		0: "DefaultAggregation",
+		1: "Device",
+		2: "Site",
+		3: "City",
+		4: "Country",
+		5: "Region",
+		6: "Attribute",
+	}
+	L8TopologyAggregation_value = map[string]int32{
+		"DefaultAggregation": 0,
+		"Device":             1,
+		"Site":               2,
+		"City":               3,
+		"Country":            4,
+		"Region":             5,
+		"Attribute":          6,
+	}
+)
+
+func (x L8TopologyAggregation) Enum() *L8TopologyAggregation {
+	p := new(L8TopologyAggregation)
+	*p = x
+	return p
+}
+
+func (x L8TopologyAggregation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (L8TopologyAggregation) Descriptor() protoreflect.EnumDescriptor {
+	return file_topology_proto_enumTypes[1].Descriptor()
+}
+
+func (L8TopologyAggregation) Type() protoreflect.EnumType {
+	return &file_topology_proto_enumTypes[1]
+}
+
+func (x L8TopologyAggregation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use L8TopologyAggregation.Descriptor instead.
+func (L8TopologyAggregation) EnumDescriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{1}
+}
+
 type L8TopologyNodeType int32
 
 const (
@@ -129,11 +193,11 @@ func (x L8TopologyNodeType) String() string {
 }
 
 func (L8TopologyNodeType) Descriptor() protoreflect.EnumDescriptor {
-	return file_topology_proto_enumTypes[1].Descriptor()
+	return file_topology_proto_enumTypes[2].Descriptor()
 }
 
 func (L8TopologyNodeType) Type() protoreflect.EnumType {
-	return &file_topology_proto_enumTypes[1]
+	return &file_topology_proto_enumTypes[2]
 }
 
 func (x L8TopologyNodeType) Number() protoreflect.EnumNumber {
@@ -142,7 +206,181 @@ func (x L8TopologyNodeType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use L8TopologyNodeType.Descriptor instead.
 func (L8TopologyNodeType) EnumDescriptor() ([]byte, []int) {
-	return file_topology_proto_rawDescGZIP(), []int{1}
+	return file_topology_proto_rawDescGZIP(), []int{2}
+}
+
+type L8TopologyNodeStatus int32
+
+const (
+	L8TopologyNodeStatus_InvalidNodeStatus L8TopologyNodeStatus = 0
+	L8TopologyNodeStatus_NodeUp            L8TopologyNodeStatus = 1
+	L8TopologyNodeStatus_NodeDown          L8TopologyNodeStatus = 2
+	L8TopologyNodeStatus_NodePartial       L8TopologyNodeStatus = 3
+)
+
+// Enum value maps for L8TopologyNodeStatus.
+var (
+	L8TopologyNodeStatus_name = map[int32]string{
+		0: "InvalidNodeStatus",
+		1: "NodeUp",
+		2: "NodeDown",
+		3: "NodePartial",
+	}
+	L8TopologyNodeStatus_value = map[string]int32{
+		"InvalidNodeStatus": 0,
+		"NodeUp":            1,
+		"NodeDown":          2,
+		"NodePartial":       3,
+	}
+)
+
+func (x L8TopologyNodeStatus) Enum() *L8TopologyNodeStatus {
+	p := new(L8TopologyNodeStatus)
+	*p = x
+	return p
+}
+
+func (x L8TopologyNodeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (L8TopologyNodeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_topology_proto_enumTypes[3].Descriptor()
+}
+
+func (L8TopologyNodeStatus) Type() protoreflect.EnumType {
+	return &file_topology_proto_enumTypes[3]
+}
+
+func (x L8TopologyNodeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use L8TopologyNodeStatus.Descriptor instead.
+func (L8TopologyNodeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{3}
+}
+
+type L8TopologyLocationResolution int32
+
+const (
+	L8TopologyLocationResolution_UnknownResolution    L8TopologyLocationResolution = 0
+	L8TopologyLocationResolution_ProvidedResolution   L8TopologyLocationResolution = 1
+	L8TopologyLocationResolution_ExactResolution      L8TopologyLocationResolution = 2
+	L8TopologyLocationResolution_NormalizedResolution L8TopologyLocationResolution = 3
+	L8TopologyLocationResolution_AliasResolution      L8TopologyLocationResolution = 4
+	L8TopologyLocationResolution_SiteCodeResolution   L8TopologyLocationResolution = 5
+	L8TopologyLocationResolution_FuzzyResolution      L8TopologyLocationResolution = 6
+	L8TopologyLocationResolution_CountryResolution    L8TopologyLocationResolution = 7
+	L8TopologyLocationResolution_Unresolved           L8TopologyLocationResolution = 8
+)
+
+// Enum value maps for L8TopologyLocationResolution.
+var (
+	L8TopologyLocationResolution_name = map[int32]string{
+		0: "UnknownResolution",
+		1: "ProvidedResolution",
+		2: "ExactResolution",
+		3: "NormalizedResolution",
+		4: "AliasResolution",
+		5: "SiteCodeResolution",
+		6: "FuzzyResolution",
+		7: "CountryResolution",
+		8: "Unresolved",
+	}
+	L8TopologyLocationResolution_value = map[string]int32{
+		"UnknownResolution":    0,
+		"ProvidedResolution":   1,
+		"ExactResolution":      2,
+		"NormalizedResolution": 3,
+		"AliasResolution":      4,
+		"SiteCodeResolution":   5,
+		"FuzzyResolution":      6,
+		"CountryResolution":    7,
+		"Unresolved":           8,
+	}
+)
+
+func (x L8TopologyLocationResolution) Enum() *L8TopologyLocationResolution {
+	p := new(L8TopologyLocationResolution)
+	*p = x
+	return p
+}
+
+func (x L8TopologyLocationResolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (L8TopologyLocationResolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_topology_proto_enumTypes[4].Descriptor()
+}
+
+func (L8TopologyLocationResolution) Type() protoreflect.EnumType {
+	return &file_topology_proto_enumTypes[4]
+}
+
+func (x L8TopologyLocationResolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use L8TopologyLocationResolution.Descriptor instead.
+func (L8TopologyLocationResolution) EnumDescriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{4}
+}
+
+type L8TopologyLocationKind int32
+
+const (
+	L8TopologyLocationKind_GeoLocation      L8TopologyLocationKind = 0
+	L8TopologyLocationKind_BuildingLocation L8TopologyLocationKind = 1
+	L8TopologyLocationKind_FloorLocation    L8TopologyLocationKind = 2
+	L8TopologyLocationKind_RoomLocation     L8TopologyLocationKind = 3
+	L8TopologyLocationKind_RackLocation     L8TopologyLocationKind = 4
+)
+
+// Enum value maps for L8TopologyLocationKind.
+var (
+	L8TopologyLocationKind_name = map[int32]string{
+		0: "GeoLocation",
+		1: "BuildingLocation",
+		2: "FloorLocation",
+		3: "RoomLocation",
+		4: "RackLocation",
+	}
+	L8TopologyLocationKind_value = map[string]int32{
+		"GeoLocation":      0,
+		"BuildingLocation": 1,
+		"FloorLocation":    2,
+		"RoomLocation":     3,
+		"RackLocation":     4,
+	}
+)
+
+func (x L8TopologyLocationKind) Enum() *L8TopologyLocationKind {
+	p := new(L8TopologyLocationKind)
+	*p = x
+	return p
+}
+
+func (x L8TopologyLocationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (L8TopologyLocationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_topology_proto_enumTypes[5].Descriptor()
+}
+
+func (L8TopologyLocationKind) Type() protoreflect.EnumType {
+	return &file_topology_proto_enumTypes[5]
+}
+
+func (x L8TopologyLocationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use L8TopologyLocationKind.Descriptor instead.
+func (L8TopologyLocationKind) EnumDescriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{5}
 }
 
 type L8TopologyLinkDirection int32
@@ -181,11 +419,11 @@ func (x L8TopologyLinkDirection) String() string {
 }
 
 func (L8TopologyLinkDirection) Descriptor() protoreflect.EnumDescriptor {
-	return file_topology_proto_enumTypes[2].Descriptor()
+	return file_topology_proto_enumTypes[6].Descriptor()
 }
 
 func (L8TopologyLinkDirection) Type() protoreflect.EnumType {
-	return &file_topology_proto_enumTypes[2]
+	return &file_topology_proto_enumTypes[6]
 }
 
 func (x L8TopologyLinkDirection) Number() protoreflect.EnumNumber {
@@ -194,7 +432,7 @@ func (x L8TopologyLinkDirection) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use L8TopologyLinkDirection.Descriptor instead.
 func (L8TopologyLinkDirection) EnumDescriptor() ([]byte, []int) {
-	return file_topology_proto_rawDescGZIP(), []int{2}
+	return file_topology_proto_rawDescGZIP(), []int{6}
 }
 
 type L8TopologyLinkStatus int32
@@ -233,11 +471,11 @@ func (x L8TopologyLinkStatus) String() string {
 }
 
 func (L8TopologyLinkStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_topology_proto_enumTypes[3].Descriptor()
+	return file_topology_proto_enumTypes[7].Descriptor()
 }
 
 func (L8TopologyLinkStatus) Type() protoreflect.EnumType {
-	return &file_topology_proto_enumTypes[3]
+	return &file_topology_proto_enumTypes[7]
 }
 
 func (x L8TopologyLinkStatus) Number() protoreflect.EnumNumber {
@@ -246,23 +484,179 @@ func (x L8TopologyLinkStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use L8TopologyLinkStatus.Descriptor instead.
 func (L8TopologyLinkStatus) EnumDescriptor() ([]byte, []int) {
-	return file_topology_proto_rawDescGZIP(), []int{3}
+	return file_topology_proto_rawDescGZIP(), []int{7}
 }
 
-type L8TopologyQuery struct {
+type L8TopologyUtilizationLevel int32
+
+const (
+	L8TopologyUtilizationLevel_UnknownUtilization  L8TopologyUtilizationLevel = 0
+	L8TopologyUtilizationLevel_LowUtilization      L8TopologyUtilizationLevel = 1
+	L8TopologyUtilizationLevel_MediumUtilization   L8TopologyUtilizationLevel = 2
+	L8TopologyUtilizationLevel_HighUtilization     L8TopologyUtilizationLevel = 3
+	L8TopologyUtilizationLevel_CriticalUtilization L8TopologyUtilizationLevel = 4
+)
+
+// Enum value maps for L8TopologyUtilizationLevel.
+var (
+	L8TopologyUtilizationLevel_name = map[int32]string{
+		0: "UnknownUtilization",
+		1: "LowUtilization",
+		2: "MediumUtilization",
+		3: "HighUtilization",
+		4: "CriticalUtilization",
+	}
+	L8TopologyUtilizationLevel_value = map[string]int32{
+		"UnknownUtilization":  0,
+		"LowUtilization":      1,
+		"MediumUtilization":   2,
+		"HighUtilization":     3,
+		"CriticalUtilization": 4,
+	}
+)
+
+func (x L8TopologyUtilizationLevel) Enum() *L8TopologyUtilizationLevel {
+	p := new(L8TopologyUtilizationLevel)
+	*p = x
+	return p
+}
+
+func (x L8TopologyUtilizationLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (L8TopologyUtilizationLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_topology_proto_enumTypes[8].Descriptor()
+}
+
+func (L8TopologyUtilizationLevel) Type() protoreflect.EnumType {
+	return &file_topology_proto_enumTypes[8]
+}
+
+func (x L8TopologyUtilizationLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use L8TopologyUtilizationLevel.Descriptor instead.
+func (L8TopologyUtilizationLevel) EnumDescriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{8}
+}
+
+type L8TopologyStatus int32
+
+const (
+	L8TopologyStatus_InvalidTopologyStatus L8TopologyStatus = 0
+	L8TopologyStatus_TopologyDiscovering   L8TopologyStatus = 1
+	L8TopologyStatus_TopologyHealthy       L8TopologyStatus = 2
+	L8TopologyStatus_TopologyFailed        L8TopologyStatus = 3
+)
+
+// Enum value maps for L8TopologyStatus.
+var (
+	L8TopologyStatus_name = map[int32]string{
+		0: "InvalidTopologyStatus",
+		1: "TopologyDiscovering",
+		2: "TopologyHealthy",
+		3: "TopologyFailed",
+	}
+	L8TopologyStatus_value = map[string]int32{
+		"InvalidTopologyStatus": 0,
+		"TopologyDiscovering":   1,
+		"TopologyHealthy":       2,
+		"TopologyFailed":        3,
+	}
+)
+
+func (x L8TopologyStatus) Enum() *L8TopologyStatus {
+	p := new(L8TopologyStatus)
+	*p = x
+	return p
+}
+
+func (x L8TopologyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (L8TopologyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_topology_proto_enumTypes[9].Descriptor()
+}
+
+func (L8TopologyStatus) Type() protoreflect.EnumType {
+	return &file_topology_proto_enumTypes[9]
+}
+
+func (x L8TopologyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use L8TopologyStatus.Descriptor instead.
+func (L8TopologyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{9}
+}
+
+type L8RoutingProtocol int32
+
+const (
+	L8RoutingProtocol_InvalidRoutingProtocol L8RoutingProtocol = 0
+	L8RoutingProtocol_OSPF                   L8RoutingProtocol = 1
+	L8RoutingProtocol_ISIS                   L8RoutingProtocol = 2
+	L8RoutingProtocol_BGP                    L8RoutingProtocol = 3
+)
+
+// Enum value maps for L8RoutingProtocol.
+var (
+	L8RoutingProtocol_name = map[int32]string{
+		0: "InvalidRoutingProtocol",
+		1: "OSPF",
+		2: "ISIS",
+		3: "BGP",
+	}
+	L8RoutingProtocol_value = map[string]int32{
+		"InvalidRoutingProtocol": 0,
+		"OSPF":                   1,
+		"ISIS":                   2,
+		"BGP":                    3,
+	}
+)
+
+func (x L8RoutingProtocol) Enum() *L8RoutingProtocol {
+	p := new(L8RoutingProtocol)
+	*p = x
+	return p
+}
+
+func (x L8RoutingProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (L8RoutingProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_topology_proto_enumTypes[10].Descriptor()
+}
+
+func (L8RoutingProtocol) Type() protoreflect.EnumType {
+	return &file_topology_proto_enumTypes[10]
+}
+
+func (x L8RoutingProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use L8RoutingProtocol.Descriptor instead.
+func (L8RoutingProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{10}
+}
+
+type L8TopologyDetailLevel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Layout L8TopologyLayout `protobuf:"varint,1,opt,name=layout,proto3,enum=l8topo.L8TopologyLayout" json:"layout,omitempty"`
-	X      float32          `protobuf:"fixed32,2,opt,name=x,proto3" json:"x,omitempty"`
-	Y      float32          `protobuf:"fixed32,3,opt,name=y,proto3" json:"y,omitempty"`
-	X1     float32          `protobuf:"fixed32,4,opt,name=x1,proto3" json:"x1,omitempty"`
-	Y1     float32          `protobuf:"fixed32,5,opt,name=y1,proto3" json:"y1,omitempty"`
+	MinZoom     float32               `protobuf:"fixed32,1,opt,name=min_zoom,json=minZoom,proto3" json:"min_zoom,omitempty"`
+	Aggregation L8TopologyAggregation `protobuf:"varint,2,opt,name=aggregation,proto3,enum=l8topo.L8TopologyAggregation" json:"aggregation,omitempty"`
 }
 
-func (x *L8TopologyQuery) Reset() {
-	*x = L8TopologyQuery{}
+func (x *L8TopologyDetailLevel) Reset() {
+	*x = L8TopologyDetailLevel{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_topology_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -270,13 +664,13 @@ func (x *L8TopologyQuery) Reset() {
 	}
 }
 
-func (x *L8TopologyQuery) String() string {
+func (x *L8TopologyDetailLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*L8TopologyQuery) ProtoMessage() {}
+func (*L8TopologyDetailLevel) ProtoMessage() {}
 
-func (x *L8TopologyQuery) ProtoReflect() protoreflect.Message {
+func (x *L8TopologyDetailLevel) ProtoReflect() protoreflect.Message {
 	mi := &file_topology_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -288,59 +682,70 @@ func (x *L8TopologyQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use L8TopologyQuery.ProtoReflect.Descriptor instead.
-func (*L8TopologyQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use L8TopologyDetailLevel.ProtoReflect.Descriptor instead.
+func (*L8TopologyDetailLevel) Descriptor() ([]byte, []int) {
 	return file_topology_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *L8TopologyQuery) GetLayout() L8TopologyLayout {
-	if x != nil {
-		return x.Layout
-	}
-	return L8TopologyLayout_Location
-}
-
-func (x *L8TopologyQuery) GetX() float32 {
-	if x != nil {
-		return x.X
-	}
-	return 0
-}
-
-func (x *L8TopologyQuery) GetY() float32 {
-	if x != nil {
-		return x.Y
-	}
-	return 0
-}
-
-func (x *L8TopologyQuery) GetX1() float32 {
+func (x *L8TopologyDetailLevel) GetMinZoom() float32 {
 	if x != nil {
-		return x.X1
+		return x.MinZoom
 	}
 	return 0
 }
 
-func (x *L8TopologyQuery) GetY1() float32 {
+func (x *L8TopologyDetailLevel) GetAggregation() L8TopologyAggregation {
 	if x != nil {
-		return x.Y1
+		return x.Aggregation
 	}
-	return 0
+	return L8TopologyAggregation_DefaultAggregation
 }
 
-type L8Topology struct {
+type L8TopologyQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string                         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Nodes     map[string]*L8TopologyNode     `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Links     map[string]*L8TopologyLink     `protobuf:"bytes,3,rep,name=links,proto3" json:"links,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Locations map[string]*L8TopologyLocation `protobuf:"bytes,4,rep,name=locations,proto3" json:"locations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Layout                L8TopologyLayout         `protobuf:"varint,1,opt,name=layout,proto3,enum=l8topo.L8TopologyLayout" json:"layout,omitempty"`
+	X                     float32                  `protobuf:"fixed32,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y                     float32                  `protobuf:"fixed32,3,opt,name=y,proto3" json:"y,omitempty"`
+	X1                    float32                  `protobuf:"fixed32,4,opt,name=x1,proto3" json:"x1,omitempty"`
+	Y1                    float32                  `protobuf:"fixed32,5,opt,name=y1,proto3" json:"y1,omitempty"`
+	Width                 float32                  `protobuf:"fixed32,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height                float32                  `protobuf:"fixed32,7,opt,name=height,proto3" json:"height,omitempty"`
+	RouteEdges            bool                     `protobuf:"varint,8,opt,name=route_edges,json=routeEdges,proto3" json:"route_edges,omitempty"`
+	Zoom                  float32                  `protobuf:"fixed32,9,opt,name=zoom,proto3" json:"zoom,omitempty"`
+	NodeTypes             []L8TopologyNodeType     `protobuf:"varint,10,rep,packed,name=node_types,json=nodeTypes,proto3,enum=l8topo.L8TopologyNodeType" json:"node_types,omitempty"`
+	NamePattern           string                   `protobuf:"bytes,11,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	LocationPattern       string                   `protobuf:"bytes,12,opt,name=location_pattern,json=locationPattern,proto3" json:"location_pattern,omitempty"`
+	LinkStatuses          []L8TopologyLinkStatus   `protobuf:"varint,13,rep,packed,name=link_statuses,json=linkStatuses,proto3,enum=l8topo.L8TopologyLinkStatus" json:"link_statuses,omitempty"`
+	CenterNodeId          string                   `protobuf:"bytes,14,opt,name=center_node_id,json=centerNodeId,proto3" json:"center_node_id,omitempty"`
+	Hops                  int32                    `protobuf:"varint,15,opt,name=hops,proto3" json:"hops,omitempty"`
+	Aggregation           L8TopologyAggregation    `protobuf:"varint,16,opt,name=aggregation,proto3,enum=l8topo.L8TopologyAggregation" json:"aggregation,omitempty"`
+	DetailLevels          []*L8TopologyDetailLevel `protobuf:"bytes,17,rep,name=detail_levels,json=detailLevels,proto3" json:"detail_levels,omitempty"`
+	PageSize              int32                    `protobuf:"varint,18,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor                string                   `protobuf:"bytes,19,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Revision              string                   `protobuf:"bytes,20,opt,name=revision,proto3" json:"revision,omitempty"`
+	AttributePatterns     map[string]string        `protobuf:"bytes,21,rep,name=attribute_patterns,json=attributePatterns,proto3" json:"attribute_patterns,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LinkAttributePatterns map[string]string        `protobuf:"bytes,22,rep,name=link_attribute_patterns,json=linkAttributePatterns,proto3" json:"link_attribute_patterns,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	GroupByAttribute      string                   `protobuf:"bytes,23,opt,name=group_by_attribute,json=groupByAttribute,proto3" json:"group_by_attribute,omitempty"`
+	Heatmap               bool                     `protobuf:"varint,24,opt,name=heatmap,proto3" json:"heatmap,omitempty"`
+	UtilizationThreshold  float32                  `protobuf:"fixed32,25,opt,name=utilization_threshold,json=utilizationThreshold,proto3" json:"utilization_threshold,omitempty"`
+	UtilizationBands      []float32                `protobuf:"fixed32,26,rep,packed,name=utilization_bands,json=utilizationBands,proto3" json:"utilization_bands,omitempty"`
+	UnresolvedLocations   bool                     `protobuf:"varint,27,opt,name=unresolved_locations,json=unresolvedLocations,proto3" json:"unresolved_locations,omitempty"`
+	MinConfidence         float32                  `protobuf:"fixed32,28,opt,name=min_confidence,json=minConfidence,proto3" json:"min_confidence,omitempty"`
+	Projection            string                   `protobuf:"bytes,29,opt,name=projection,proto3" json:"projection,omitempty"`
+	FloorPlan             string                   `protobuf:"bytes,30,opt,name=floor_plan,json=floorPlan,proto3" json:"floor_plan,omitempty"`
+	DiscoveryReports      bool                     `protobuf:"varint,31,opt,name=discovery_reports,json=discoveryReports,proto3" json:"discovery_reports,omitempty"`
+	MapServiceName        string                   `protobuf:"bytes,32,opt,name=map_service_name,json=mapServiceName,proto3" json:"map_service_name,omitempty"`
+	MapServiceArea        int32                    `protobuf:"varint,33,opt,name=map_service_area,json=mapServiceArea,proto3" json:"map_service_area,omitempty"`
+	MapLinkIds            []string                 `protobuf:"bytes,34,rep,name=map_link_ids,json=mapLinkIds,proto3" json:"map_link_ids,omitempty"`
+	MapNodeIds            []string                 `protobuf:"bytes,35,rep,name=map_node_ids,json=mapNodeIds,proto3" json:"map_node_ids,omitempty"`
+	Raw                   bool                     `protobuf:"varint,36,opt,name=raw,proto3" json:"raw,omitempty"`
 }
 
-func (x *L8Topology) Reset() {
-	*x = L8Topology{}
+func (x *L8TopologyQuery) Reset() {
+	*x = L8TopologyQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_topology_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -348,13 +753,13 @@ func (x *L8Topology) Reset() {
 	}
 }
 
-func (x *L8Topology) String() string {
+func (x *L8TopologyQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*L8Topology) ProtoMessage() {}
+func (*L8TopologyQuery) ProtoMessage() {}
 
-func (x *L8Topology) ProtoReflect() protoreflect.Message {
+func (x *L8TopologyQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_topology_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -366,68 +771,1618 @@ func (x *L8Topology) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use L8Topology.ProtoReflect.Descriptor instead.
-func (*L8Topology) Descriptor() ([]byte, []int) {
+// Deprecated: Use L8TopologyQuery.ProtoReflect.Descriptor instead.
+func (*L8TopologyQuery) Descriptor() ([]byte, []int) {
 	return file_topology_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *L8Topology) GetName() string {
+func (x *L8TopologyQuery) GetLayout() L8TopologyLayout {
 	if x != nil {
-		return x.Name
+		return x.Layout
 	}
-	return ""
+	return L8TopologyLayout_Location
 }
 
-func (x *L8Topology) GetNodes() map[string]*L8TopologyNode {
+func (x *L8TopologyQuery) GetX() float32 {
 	if x != nil {
-		return x.Nodes
+		return x.X
+	}
+	return 0
+}
+
+func (x *L8TopologyQuery) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *L8TopologyQuery) GetX1() float32 {
+	if x != nil {
+		return x.X1
+	}
+	return 0
+}
+
+func (x *L8TopologyQuery) GetY1() float32 {
+	if x != nil {
+		return x.Y1
+	}
+	return 0
+}
+
+func (x *L8TopologyQuery) GetWidth() float32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *L8TopologyQuery) GetHeight() float32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *L8TopologyQuery) GetRouteEdges() bool {
+	if x != nil {
+		return x.RouteEdges
+	}
+	return false
+}
+
+func (x *L8TopologyQuery) GetZoom() float32 {
+	if x != nil {
+		return x.Zoom
+	}
+	return 0
+}
+
+func (x *L8TopologyQuery) GetNodeTypes() []L8TopologyNodeType {
+	if x != nil {
+		return x.NodeTypes
+	}
+	return nil
+}
+
+func (x *L8TopologyQuery) GetNamePattern() string {
+	if x != nil {
+		return x.NamePattern
+	}
+	return ""
+}
+
+func (x *L8TopologyQuery) GetLocationPattern() string {
+	if x != nil {
+		return x.LocationPattern
+	}
+	return ""
+}
+
+func (x *L8TopologyQuery) GetLinkStatuses() []L8TopologyLinkStatus {
+	if x != nil {
+		return x.LinkStatuses
+	}
+	return nil
+}
+
+func (x *L8TopologyQuery) GetCenterNodeId() string {
+	if x != nil {
+		return x.CenterNodeId
+	}
+	return ""
+}
+
+func (x *L8TopologyQuery) GetHops() int32 {
+	if x != nil {
+		return x.Hops
+	}
+	return 0
+}
+
+func (x *L8TopologyQuery) GetAggregation() L8TopologyAggregation {
+	if x != nil {
+		return x.Aggregation
+	}
+	return L8TopologyAggregation_DefaultAggregation
+}
+
+func (x *L8TopologyQuery) GetDetailLevels() []*L8TopologyDetailLevel {
+	if x != nil {
+		return x.DetailLevels
+	}
+	return nil
+}
+
+func (x *L8TopologyQuery) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *L8TopologyQuery) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *L8TopologyQuery) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *L8TopologyQuery) GetAttributePatterns() map[string]string {
+	if x != nil {
+		return x.AttributePatterns
+	}
+	return nil
+}
+
+func (x *L8TopologyQuery) GetLinkAttributePatterns() map[string]string {
+	if x != nil {
+		return x.LinkAttributePatterns
+	}
+	return nil
+}
+
+func (x *L8TopologyQuery) GetGroupByAttribute() string {
+	if x != nil {
+		return x.GroupByAttribute
+	}
+	return ""
+}
+
+func (x *L8TopologyQuery) GetHeatmap() bool {
+	if x != nil {
+		return x.Heatmap
+	}
+	return false
+}
+
+func (x *L8TopologyQuery) GetUtilizationThreshold() float32 {
+	if x != nil {
+		return x.UtilizationThreshold
+	}
+	return 0
+}
+
+func (x *L8TopologyQuery) GetUtilizationBands() []float32 {
+	if x != nil {
+		return x.UtilizationBands
+	}
+	return nil
+}
+
+func (x *L8TopologyQuery) GetUnresolvedLocations() bool {
+	if x != nil {
+		return x.UnresolvedLocations
+	}
+	return false
+}
+
+func (x *L8TopologyQuery) GetMinConfidence() float32 {
+	if x != nil {
+		return x.MinConfidence
+	}
+	return 0
+}
+
+func (x *L8TopologyQuery) GetProjection() string {
+	if x != nil {
+		return x.Projection
+	}
+	return ""
+}
+
+func (x *L8TopologyQuery) GetFloorPlan() string {
+	if x != nil {
+		return x.FloorPlan
+	}
+	return ""
+}
+
+func (x *L8TopologyQuery) GetDiscoveryReports() bool {
+	if x != nil {
+		return x.DiscoveryReports
+	}
+	return false
+}
+
+func (x *L8TopologyQuery) GetMapServiceName() string {
+	if x != nil {
+		return x.MapServiceName
+	}
+	return ""
+}
+
+func (x *L8TopologyQuery) GetMapServiceArea() int32 {
+	if x != nil {
+		return x.MapServiceArea
+	}
+	return 0
+}
+
+func (x *L8TopologyQuery) GetMapLinkIds() []string {
+	if x != nil {
+		return x.MapLinkIds
+	}
+	return nil
+}
+
+func (x *L8TopologyQuery) GetMapNodeIds() []string {
+	if x != nil {
+		return x.MapNodeIds
+	}
+	return nil
+}
+
+func (x *L8TopologyQuery) GetRaw() bool {
+	if x != nil {
+		return x.Raw
+	}
+	return false
+}
+
+type L8Topology struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string                         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Nodes            map[string]*L8TopologyNode     `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Links            map[string]*L8TopologyLink     `protobuf:"bytes,3,rep,name=links,proto3" json:"links,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Locations        map[string]*L8TopologyLocation `protobuf:"bytes,4,rep,name=locations,proto3" json:"locations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Width            float32                        `protobuf:"fixed32,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height           float32                        `protobuf:"fixed32,6,opt,name=height,proto3" json:"height,omitempty"`
+	NextCursor       string                         `protobuf:"bytes,7,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	TotalNodes       int32                          `protobuf:"varint,8,opt,name=total_nodes,json=totalNodes,proto3" json:"total_nodes,omitempty"`
+	TotalLinks       int32                          `protobuf:"varint,9,opt,name=total_links,json=totalLinks,proto3" json:"total_links,omitempty"`
+	Revision         string                         `protobuf:"bytes,10,opt,name=revision,proto3" json:"revision,omitempty"`
+	Delta            bool                           `protobuf:"varint,11,opt,name=delta,proto3" json:"delta,omitempty"`
+	RemovedNodes     []string                       `protobuf:"bytes,12,rep,name=removed_nodes,json=removedNodes,proto3" json:"removed_nodes,omitempty"`
+	RemovedLinks     []string                       `protobuf:"bytes,13,rep,name=removed_links,json=removedLinks,proto3" json:"removed_links,omitempty"`
+	RemovedLocations []string                       `protobuf:"bytes,14,rep,name=removed_locations,json=removedLocations,proto3" json:"removed_locations,omitempty"`
+	Projection       string                         `protobuf:"bytes,15,opt,name=projection,proto3" json:"projection,omitempty"`
+	FloorPlanImage   string                         `protobuf:"bytes,16,opt,name=floor_plan_image,json=floorPlanImage,proto3" json:"floor_plan_image,omitempty"`
+	DiscoveryReports []*L8TopologyDiscoveryReport   `protobuf:"bytes,17,rep,name=discovery_reports,json=discoveryReports,proto3" json:"discovery_reports,omitempty"`
+}
+
+func (x *L8Topology) Reset() {
+	*x = L8Topology{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topology_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8Topology) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8Topology) ProtoMessage() {}
+
+func (x *L8Topology) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8Topology.ProtoReflect.Descriptor instead.
+func (*L8Topology) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *L8Topology) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8Topology) GetNodes() map[string]*L8TopologyNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *L8Topology) GetLinks() map[string]*L8TopologyLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *L8Topology) GetLocations() map[string]*L8TopologyLocation {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *L8Topology) GetWidth() float32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *L8Topology) GetHeight() float32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *L8Topology) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *L8Topology) GetTotalNodes() int32 {
+	if x != nil {
+		return x.TotalNodes
+	}
+	return 0
+}
+
+func (x *L8Topology) GetTotalLinks() int32 {
+	if x != nil {
+		return x.TotalLinks
+	}
+	return 0
+}
+
+func (x *L8Topology) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *L8Topology) GetDelta() bool {
+	if x != nil {
+		return x.Delta
+	}
+	return false
+}
+
+func (x *L8Topology) GetRemovedNodes() []string {
+	if x != nil {
+		return x.RemovedNodes
+	}
+	return nil
+}
+
+func (x *L8Topology) GetRemovedLinks() []string {
+	if x != nil {
+		return x.RemovedLinks
+	}
+	return nil
+}
+
+func (x *L8Topology) GetRemovedLocations() []string {
+	if x != nil {
+		return x.RemovedLocations
+	}
+	return nil
+}
+
+func (x *L8Topology) GetProjection() string {
+	if x != nil {
+		return x.Projection
+	}
+	return ""
+}
+
+func (x *L8Topology) GetFloorPlanImage() string {
+	if x != nil {
+		return x.FloorPlanImage
+	}
+	return ""
+}
+
+func (x *L8Topology) GetDiscoveryReports() []*L8TopologyDiscoveryReport {
+	if x != nil {
+		return x.DiscoveryReports
+	}
+	return nil
+}
+
+type L8TopologyDiscoveryReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempt             int32    `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	StartTime           int64    `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime             int64    `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ResponseStatus      string   `protobuf:"bytes,4,opt,name=response_status,json=responseStatus,proto3" json:"response_status,omitempty"`
+	Error               string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Devices             int32    `protobuf:"varint,6,opt,name=devices,proto3" json:"devices,omitempty"`
+	Ports               int32    `protobuf:"varint,7,opt,name=ports,proto3" json:"ports,omitempty"`
+	Links               int32    `protobuf:"varint,8,opt,name=links,proto3" json:"links,omitempty"`
+	UnresolvedLocations int32    `protobuf:"varint,9,opt,name=unresolved_locations,json=unresolvedLocations,proto3" json:"unresolved_locations,omitempty"`
+	ConversionErrors    []string `protobuf:"bytes,10,rep,name=conversion_errors,json=conversionErrors,proto3" json:"conversion_errors,omitempty"`
+	NextRetry           int64    `protobuf:"varint,11,opt,name=next_retry,json=nextRetry,proto3" json:"next_retry,omitempty"`
+	Source              string   `protobuf:"bytes,12,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *L8TopologyDiscoveryReport) Reset() {
+	*x = L8TopologyDiscoveryReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topology_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8TopologyDiscoveryReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8TopologyDiscoveryReport) ProtoMessage() {}
+
+func (x *L8TopologyDiscoveryReport) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8TopologyDiscoveryReport.ProtoReflect.Descriptor instead.
+func (*L8TopologyDiscoveryReport) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *L8TopologyDiscoveryReport) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *L8TopologyDiscoveryReport) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *L8TopologyDiscoveryReport) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *L8TopologyDiscoveryReport) GetResponseStatus() string {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return ""
+}
+
+func (x *L8TopologyDiscoveryReport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *L8TopologyDiscoveryReport) GetDevices() int32 {
+	if x != nil {
+		return x.Devices
+	}
+	return 0
+}
+
+func (x *L8TopologyDiscoveryReport) GetPorts() int32 {
+	if x != nil {
+		return x.Ports
+	}
+	return 0
+}
+
+func (x *L8TopologyDiscoveryReport) GetLinks() int32 {
+	if x != nil {
+		return x.Links
+	}
+	return 0
+}
+
+func (x *L8TopologyDiscoveryReport) GetUnresolvedLocations() int32 {
+	if x != nil {
+		return x.UnresolvedLocations
+	}
+	return 0
+}
+
+func (x *L8TopologyDiscoveryReport) GetConversionErrors() []string {
+	if x != nil {
+		return x.ConversionErrors
+	}
+	return nil
+}
+
+func (x *L8TopologyDiscoveryReport) GetNextRetry() int64 {
+	if x != nil {
+		return x.NextRetry
+	}
+	return 0
+}
+
+func (x *L8TopologyDiscoveryReport) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type L8TopologyNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId     string               `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Name       string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location   string               `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Count      int32                `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Type       L8TopologyNodeType   `protobuf:"varint,5,opt,name=type,proto3,enum=l8topo.L8TopologyNodeType" json:"type,omitempty"`
+	MemberIds  []string             `protobuf:"bytes,6,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	Status     L8TopologyNodeStatus `protobuf:"varint,7,opt,name=status,proto3,enum=l8topo.L8TopologyNodeStatus" json:"status,omitempty"`
+	Attributes map[string]string    `protobuf:"bytes,8,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Sources    []string             `protobuf:"bytes,9,rep,name=sources,proto3" json:"sources,omitempty"`
+	MappedFrom []string             `protobuf:"bytes,10,rep,name=mapped_from,json=mappedFrom,proto3" json:"mapped_from,omitempty"`
+}
+
+func (x *L8TopologyNode) Reset() {
+	*x = L8TopologyNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topology_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8TopologyNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8TopologyNode) ProtoMessage() {}
+
+func (x *L8TopologyNode) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8TopologyNode.ProtoReflect.Descriptor instead.
+func (*L8TopologyNode) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *L8TopologyNode) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *L8TopologyNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8TopologyNode) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *L8TopologyNode) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *L8TopologyNode) GetType() L8TopologyNodeType {
+	if x != nil {
+		return x.Type
+	}
+	return L8TopologyNodeType_Generic
+}
+
+func (x *L8TopologyNode) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+func (x *L8TopologyNode) GetStatus() L8TopologyNodeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return L8TopologyNodeStatus_InvalidNodeStatus
+}
+
+func (x *L8TopologyNode) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *L8TopologyNode) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *L8TopologyNode) GetMappedFrom() []string {
+	if x != nil {
+		return x.MappedFrom
+	}
+	return nil
+}
+
+type L8TopologyLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location        string                       `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Longitude       float32                      `protobuf:"fixed32,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude        float32                      `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	SvgX            float32                      `protobuf:"fixed32,4,opt,name=svg_x,json=svgX,proto3" json:"svg_x,omitempty"`
+	SvgY            float32                      `protobuf:"fixed32,5,opt,name=svg_y,json=svgY,proto3" json:"svg_y,omitempty"`
+	DisplayX        float32                      `protobuf:"fixed32,6,opt,name=display_x,json=displayX,proto3" json:"display_x,omitempty"`
+	DisplayY        float32                      `protobuf:"fixed32,7,opt,name=display_y,json=displayY,proto3" json:"display_y,omitempty"`
+	Attributes      map[string]string            `protobuf:"bytes,8,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Resolution      L8TopologyLocationResolution `protobuf:"varint,9,opt,name=resolution,proto3,enum=l8topo.L8TopologyLocationResolution" json:"resolution,omitempty"`
+	Confidence      float32                      `protobuf:"fixed32,10,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	ResolvedAs      string                       `protobuf:"bytes,11,opt,name=resolved_as,json=resolvedAs,proto3" json:"resolved_as,omitempty"`
+	Parent          string                       `protobuf:"bytes,12,opt,name=parent,proto3" json:"parent,omitempty"`
+	Kind            L8TopologyLocationKind       `protobuf:"varint,13,opt,name=kind,proto3,enum=l8topo.L8TopologyLocationKind" json:"kind,omitempty"`
+	LocalX          float32                      `protobuf:"fixed32,14,opt,name=local_x,json=localX,proto3" json:"local_x,omitempty"`
+	LocalY          float32                      `protobuf:"fixed32,15,opt,name=local_y,json=localY,proto3" json:"local_y,omitempty"`
+	FloorPlanImage  string                       `protobuf:"bytes,16,opt,name=floor_plan_image,json=floorPlanImage,proto3" json:"floor_plan_image,omitempty"`
+	FloorPlanWidth  float32                      `protobuf:"fixed32,17,opt,name=floor_plan_width,json=floorPlanWidth,proto3" json:"floor_plan_width,omitempty"`
+	FloorPlanHeight float32                      `protobuf:"fixed32,18,opt,name=floor_plan_height,json=floorPlanHeight,proto3" json:"floor_plan_height,omitempty"`
+}
+
+func (x *L8TopologyLocation) Reset() {
+	*x = L8TopologyLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topology_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8TopologyLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8TopologyLocation) ProtoMessage() {}
+
+func (x *L8TopologyLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8TopologyLocation.ProtoReflect.Descriptor instead.
+func (*L8TopologyLocation) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *L8TopologyLocation) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *L8TopologyLocation) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *L8TopologyLocation) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *L8TopologyLocation) GetSvgX() float32 {
+	if x != nil {
+		return x.SvgX
+	}
+	return 0
+}
+
+func (x *L8TopologyLocation) GetSvgY() float32 {
+	if x != nil {
+		return x.SvgY
+	}
+	return 0
+}
+
+func (x *L8TopologyLocation) GetDisplayX() float32 {
+	if x != nil {
+		return x.DisplayX
+	}
+	return 0
+}
+
+func (x *L8TopologyLocation) GetDisplayY() float32 {
+	if x != nil {
+		return x.DisplayY
+	}
+	return 0
+}
+
+func (x *L8TopologyLocation) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *L8TopologyLocation) GetResolution() L8TopologyLocationResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return L8TopologyLocationResolution_UnknownResolution
+}
+
+func (x *L8TopologyLocation) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *L8TopologyLocation) GetResolvedAs() string {
+	if x != nil {
+		return x.ResolvedAs
+	}
+	return ""
+}
+
+func (x *L8TopologyLocation) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *L8TopologyLocation) GetKind() L8TopologyLocationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return L8TopologyLocationKind_GeoLocation
+}
+
+func (x *L8TopologyLocation) GetLocalX() float32 {
+	if x != nil {
+		return x.LocalX
+	}
+	return 0
+}
+
+func (x *L8TopologyLocation) GetLocalY() float32 {
+	if x != nil {
+		return x.LocalY
+	}
+	return 0
+}
+
+func (x *L8TopologyLocation) GetFloorPlanImage() string {
+	if x != nil {
+		return x.FloorPlanImage
+	}
+	return ""
+}
+
+func (x *L8TopologyLocation) GetFloorPlanWidth() float32 {
+	if x != nil {
+		return x.FloorPlanWidth
+	}
+	return 0
+}
+
+func (x *L8TopologyLocation) GetFloorPlanHeight() float32 {
+	if x != nil {
+		return x.FloorPlanHeight
+	}
+	return 0
+}
+
+type L8TopologyLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkId               string                     `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	Aside                string                     `protobuf:"bytes,2,opt,name=aside,proto3" json:"aside,omitempty"`
+	Zside                string                     `protobuf:"bytes,3,opt,name=zside,proto3" json:"zside,omitempty"`
+	Direction            L8TopologyLinkDirection    `protobuf:"varint,4,opt,name=direction,proto3,enum=l8topo.L8TopologyLinkDirection" json:"direction,omitempty"`
+	Status               L8TopologyLinkStatus       `protobuf:"varint,5,opt,name=status,proto3,enum=l8topo.L8TopologyLinkStatus" json:"status,omitempty"`
+	BendPoints           []*L8TopologyPoint         `protobuf:"bytes,6,rep,name=bend_points,json=bendPoints,proto3" json:"bend_points,omitempty"`
+	MemberCount          int32                      `protobuf:"varint,7,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	MemberLinkIds        []string                   `protobuf:"bytes,8,rep,name=member_link_ids,json=memberLinkIds,proto3" json:"member_link_ids,omitempty"`
+	Capacity             uint64                     `protobuf:"varint,9,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Attributes           map[string]string          `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AsideEndpoint        *L8TopologyLinkEndpoint    `protobuf:"bytes,11,opt,name=aside_endpoint,json=asideEndpoint,proto3" json:"aside_endpoint,omitempty"`
+	ZsideEndpoint        *L8TopologyLinkEndpoint    `protobuf:"bytes,12,opt,name=zside_endpoint,json=zsideEndpoint,proto3" json:"zside_endpoint,omitempty"`
+	Utilization          float32                    `protobuf:"fixed32,13,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	ErrorRate            float32                    `protobuf:"fixed32,14,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	MetricsTime          int64                      `protobuf:"varint,15,opt,name=metrics_time,json=metricsTime,proto3" json:"metrics_time,omitempty"`
+	UtilizationLevel     L8TopologyUtilizationLevel `protobuf:"varint,16,opt,name=utilization_level,json=utilizationLevel,proto3,enum=l8topo.L8TopologyUtilizationLevel" json:"utilization_level,omitempty"`
+	MemberAsideEndpoints []*L8TopologyLinkEndpoint  `protobuf:"bytes,17,rep,name=member_aside_endpoints,json=memberAsideEndpoints,proto3" json:"member_aside_endpoints,omitempty"`
+	MemberZsideEndpoints []*L8TopologyLinkEndpoint  `protobuf:"bytes,18,rep,name=member_zside_endpoints,json=memberZsideEndpoints,proto3" json:"member_zside_endpoints,omitempty"`
+	Sources              []string                   `protobuf:"bytes,19,rep,name=sources,proto3" json:"sources,omitempty"`
+	MappedFrom           []string                   `protobuf:"bytes,20,rep,name=mapped_from,json=mappedFrom,proto3" json:"mapped_from,omitempty"`
+}
+
+func (x *L8TopologyLink) Reset() {
+	*x = L8TopologyLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topology_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8TopologyLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8TopologyLink) ProtoMessage() {}
+
+func (x *L8TopologyLink) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8TopologyLink.ProtoReflect.Descriptor instead.
+func (*L8TopologyLink) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *L8TopologyLink) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *L8TopologyLink) GetAside() string {
+	if x != nil {
+		return x.Aside
+	}
+	return ""
+}
+
+func (x *L8TopologyLink) GetZside() string {
+	if x != nil {
+		return x.Zside
+	}
+	return ""
+}
+
+func (x *L8TopologyLink) GetDirection() L8TopologyLinkDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return L8TopologyLinkDirection_InvalidDirection
+}
+
+func (x *L8TopologyLink) GetStatus() L8TopologyLinkStatus {
+	if x != nil {
+		return x.Status
+	}
+	return L8TopologyLinkStatus_InvalidStatus
+}
+
+func (x *L8TopologyLink) GetBendPoints() []*L8TopologyPoint {
+	if x != nil {
+		return x.BendPoints
+	}
+	return nil
+}
+
+func (x *L8TopologyLink) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *L8TopologyLink) GetMemberLinkIds() []string {
+	if x != nil {
+		return x.MemberLinkIds
+	}
+	return nil
+}
+
+func (x *L8TopologyLink) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *L8TopologyLink) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *L8TopologyLink) GetAsideEndpoint() *L8TopologyLinkEndpoint {
+	if x != nil {
+		return x.AsideEndpoint
+	}
+	return nil
+}
+
+func (x *L8TopologyLink) GetZsideEndpoint() *L8TopologyLinkEndpoint {
+	if x != nil {
+		return x.ZsideEndpoint
+	}
+	return nil
+}
+
+func (x *L8TopologyLink) GetUtilization() float32 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *L8TopologyLink) GetErrorRate() float32 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *L8TopologyLink) GetMetricsTime() int64 {
+	if x != nil {
+		return x.MetricsTime
+	}
+	return 0
+}
+
+func (x *L8TopologyLink) GetUtilizationLevel() L8TopologyUtilizationLevel {
+	if x != nil {
+		return x.UtilizationLevel
+	}
+	return L8TopologyUtilizationLevel_UnknownUtilization
+}
+
+func (x *L8TopologyLink) GetMemberAsideEndpoints() []*L8TopologyLinkEndpoint {
+	if x != nil {
+		return x.MemberAsideEndpoints
+	}
+	return nil
+}
+
+func (x *L8TopologyLink) GetMemberZsideEndpoints() []*L8TopologyLinkEndpoint {
+	if x != nil {
+		return x.MemberZsideEndpoints
+	}
+	return nil
+}
+
+func (x *L8TopologyLink) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *L8TopologyLink) GetMappedFrom() []string {
+	if x != nil {
+		return x.MappedFrom
+	}
+	return nil
+}
+
+type L8TopologyLinkEndpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId         string  `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	PortId         string  `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	PortName       string  `protobuf:"bytes,3,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	InterfaceName  string  `protobuf:"bytes,4,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	Speed          uint64  `protobuf:"varint,5,opt,name=speed,proto3" json:"speed,omitempty"`
+	Mtu            uint32  `protobuf:"varint,6,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	InUtilization  float32 `protobuf:"fixed32,7,opt,name=in_utilization,json=inUtilization,proto3" json:"in_utilization,omitempty"`
+	OutUtilization float32 `protobuf:"fixed32,8,opt,name=out_utilization,json=outUtilization,proto3" json:"out_utilization,omitempty"`
+	InErrorRate    float32 `protobuf:"fixed32,9,opt,name=in_error_rate,json=inErrorRate,proto3" json:"in_error_rate,omitempty"`
+	OutErrorRate   float32 `protobuf:"fixed32,10,opt,name=out_error_rate,json=outErrorRate,proto3" json:"out_error_rate,omitempty"`
+}
+
+func (x *L8TopologyLinkEndpoint) Reset() {
+	*x = L8TopologyLinkEndpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topology_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8TopologyLinkEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8TopologyLinkEndpoint) ProtoMessage() {}
+
+func (x *L8TopologyLinkEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8TopologyLinkEndpoint.ProtoReflect.Descriptor instead.
+func (*L8TopologyLinkEndpoint) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *L8TopologyLinkEndpoint) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *L8TopologyLinkEndpoint) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *L8TopologyLinkEndpoint) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *L8TopologyLinkEndpoint) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *L8TopologyLinkEndpoint) GetSpeed() uint64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *L8TopologyLinkEndpoint) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *L8TopologyLinkEndpoint) GetInUtilization() float32 {
+	if x != nil {
+		return x.InUtilization
+	}
+	return 0
+}
+
+func (x *L8TopologyLinkEndpoint) GetOutUtilization() float32 {
+	if x != nil {
+		return x.OutUtilization
+	}
+	return 0
+}
+
+func (x *L8TopologyLinkEndpoint) GetInErrorRate() float32 {
+	if x != nil {
+		return x.InErrorRate
+	}
+	return 0
+}
+
+func (x *L8TopologyLinkEndpoint) GetOutErrorRate() float32 {
+	if x != nil {
+		return x.OutErrorRate
+	}
+	return 0
+}
+
+type L8TopologyPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X float32 `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float32 `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *L8TopologyPoint) Reset() {
+	*x = L8TopologyPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topology_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8TopologyPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8TopologyPoint) ProtoMessage() {}
+
+func (x *L8TopologyPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8TopologyPoint.ProtoReflect.Descriptor instead.
+func (*L8TopologyPoint) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *L8TopologyPoint) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *L8TopologyPoint) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type L8TopologyPinnedPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string           `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Layout L8TopologyLayout `protobuf:"varint,2,opt,name=layout,proto3,enum=l8topo.L8TopologyLayout" json:"layout,omitempty"`
+	SvgX   float32          `protobuf:"fixed32,3,opt,name=svg_x,json=svgX,proto3" json:"svg_x,omitempty"`
+	SvgY   float32          `protobuf:"fixed32,4,opt,name=svg_y,json=svgY,proto3" json:"svg_y,omitempty"`
+}
+
+func (x *L8TopologyPinnedPosition) Reset() {
+	*x = L8TopologyPinnedPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topology_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8TopologyPinnedPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8TopologyPinnedPosition) ProtoMessage() {}
+
+func (x *L8TopologyPinnedPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8TopologyPinnedPosition.ProtoReflect.Descriptor instead.
+func (*L8TopologyPinnedPosition) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *L8TopologyPinnedPosition) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *L8TopologyPinnedPosition) GetLayout() L8TopologyLayout {
+	if x != nil {
+		return x.Layout
+	}
+	return L8TopologyLayout_Location
+}
+
+func (x *L8TopologyPinnedPosition) GetSvgX() float32 {
+	if x != nil {
+		return x.SvgX
+	}
+	return 0
+}
+
+func (x *L8TopologyPinnedPosition) GetSvgY() float32 {
+	if x != nil {
+		return x.SvgY
+	}
+	return 0
+}
+
+type L8TopologyPinnedPositionList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*L8TopologyPinnedPosition `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *L8TopologyPinnedPositionList) Reset() {
+	*x = L8TopologyPinnedPositionList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topology_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8TopologyPinnedPositionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8TopologyPinnedPositionList) ProtoMessage() {}
+
+func (x *L8TopologyPinnedPositionList) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8TopologyPinnedPositionList.ProtoReflect.Descriptor instead.
+func (*L8TopologyPinnedPositionList) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *L8TopologyPinnedPositionList) GetList() []*L8TopologyPinnedPosition {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type L8TopologyMetadataList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*L8TopologyMetadata `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *L8TopologyMetadataList) Reset() {
+	*x = L8TopologyMetadataList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topology_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8TopologyMetadataList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8TopologyMetadataList) ProtoMessage() {}
+
+func (x *L8TopologyMetadataList) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8TopologyMetadataList.ProtoReflect.Descriptor instead.
+func (*L8TopologyMetadataList) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *L8TopologyMetadataList) GetList() []*L8TopologyMetadata {
+	if x != nil {
+		return x.List
 	}
 	return nil
 }
 
-func (x *L8Topology) GetLinks() map[string]*L8TopologyLink {
+type L8TopologyMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ServiceName   string           `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	ServiceArea   int32            `protobuf:"varint,3,opt,name=serviceArea,proto3" json:"serviceArea,omitempty"`
+	Description   string           `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Status        L8TopologyStatus `protobuf:"varint,5,opt,name=status,proto3,enum=l8topo.L8TopologyStatus" json:"status,omitempty"`
+	Error         string           `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	NodeCount     int32            `protobuf:"varint,7,opt,name=nodeCount,proto3" json:"nodeCount,omitempty"`
+	LinkCount     int32            `protobuf:"varint,8,opt,name=linkCount,proto3" json:"linkCount,omitempty"`
+	LastDiscovery int64            `protobuf:"varint,9,opt,name=lastDiscovery,proto3" json:"lastDiscovery,omitempty"`
+}
+
+func (x *L8TopologyMetadata) Reset() {
+	*x = L8TopologyMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topology_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8TopologyMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8TopologyMetadata) ProtoMessage() {}
+
+func (x *L8TopologyMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8TopologyMetadata.ProtoReflect.Descriptor instead.
+func (*L8TopologyMetadata) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *L8TopologyMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8TopologyMetadata) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *L8TopologyMetadata) GetServiceArea() int32 {
+	if x != nil {
+		return x.ServiceArea
+	}
+	return 0
+}
+
+func (x *L8TopologyMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *L8TopologyMetadata) GetStatus() L8TopologyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return L8TopologyStatus_InvalidTopologyStatus
+}
+
+func (x *L8TopologyMetadata) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *L8TopologyMetadata) GetNodeCount() int32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *L8TopologyMetadata) GetLinkCount() int32 {
+	if x != nil {
+		return x.LinkCount
+	}
+	return 0
+}
+
+func (x *L8TopologyMetadata) GetLastDiscovery() int64 {
 	if x != nil {
-		return x.Links
+		return x.LastDiscovery
 	}
-	return nil
+	return 0
 }
 
-func (x *L8Topology) GetLocations() map[string]*L8TopologyLocation {
+type L8TopologyDiscoveryList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*L8TopologyDiscovery `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *L8TopologyDiscoveryList) Reset() {
+	*x = L8TopologyDiscoveryList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topology_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8TopologyDiscoveryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8TopologyDiscoveryList) ProtoMessage() {}
+
+func (x *L8TopologyDiscoveryList) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8TopologyDiscoveryList.ProtoReflect.Descriptor instead.
+func (*L8TopologyDiscoveryList) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *L8TopologyDiscoveryList) GetList() []*L8TopologyDiscovery {
 	if x != nil {
-		return x.Locations
+		return x.List
 	}
 	return nil
 }
 
-type L8TopologyNode struct {
+type L8TopologyDiscovery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeId   string             `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Name     string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Location string             `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	Count    int32              `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	Type     L8TopologyNodeType `protobuf:"varint,5,opt,name=type,proto3,enum=l8topo.L8TopologyNodeType" json:"type,omitempty"`
-}
-
-func (x *L8TopologyNode) Reset() {
-	*x = L8TopologyNode{}
+	Name                 string                          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ServiceName          string                          `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	ServiceArea          int32                           `protobuf:"varint,3,opt,name=service_area,json=serviceArea,proto3" json:"service_area,omitempty"`
+	InventoryServiceName string                          `protobuf:"bytes,4,opt,name=inventory_service_name,json=inventoryServiceName,proto3" json:"inventory_service_name,omitempty"`
+	InventoryServiceArea int32                           `protobuf:"varint,5,opt,name=inventory_service_area,json=inventoryServiceArea,proto3" json:"inventory_service_area,omitempty"`
+	Query                string                          `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	ModelTypeName        string                          `protobuf:"bytes,7,opt,name=model_type_name,json=modelTypeName,proto3" json:"model_type_name,omitempty"`
+	IdPath               string                          `protobuf:"bytes,8,opt,name=id_path,json=idPath,proto3" json:"id_path,omitempty"`
+	NamePath             string                          `protobuf:"bytes,9,opt,name=name_path,json=namePath,proto3" json:"name_path,omitempty"`
+	LocationPath         string                          `protobuf:"bytes,10,opt,name=location_path,json=locationPath,proto3" json:"location_path,omitempty"`
+	TypePath             string                          `protobuf:"bytes,11,opt,name=type_path,json=typePath,proto3" json:"type_path,omitempty"`
+	NodeTypes            map[string]L8TopologyNodeType   `protobuf:"bytes,12,rep,name=node_types,json=nodeTypes,proto3" json:"node_types,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=l8topo.L8TopologyNodeType"`
+	StatusPath           string                          `protobuf:"bytes,13,opt,name=status_path,json=statusPath,proto3" json:"status_path,omitempty"`
+	NodeStatuses         map[string]L8TopologyNodeStatus `protobuf:"bytes,14,rep,name=node_statuses,json=nodeStatuses,proto3" json:"node_statuses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=l8topo.L8TopologyNodeStatus"`
+	LatitudePath         string                          `protobuf:"bytes,15,opt,name=latitude_path,json=latitudePath,proto3" json:"latitude_path,omitempty"`
+	LongitudePath        string                          `protobuf:"bytes,16,opt,name=longitude_path,json=longitudePath,proto3" json:"longitude_path,omitempty"`
+	LinkRule             *L8TopologyLinkRule             `protobuf:"bytes,17,opt,name=link_rule,json=linkRule,proto3" json:"link_rule,omitempty"`
+	Projection           string                          `protobuf:"bytes,18,opt,name=projection,proto3" json:"projection,omitempty"`
+	Description          string                          `protobuf:"bytes,19,opt,name=description,proto3" json:"description,omitempty"`
+	AttributePaths       map[string]string               `protobuf:"bytes,20,rep,name=attribute_paths,json=attributePaths,proto3" json:"attribute_paths,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Sources              []*L8TopologyDiscovery          `protobuf:"bytes,21,rep,name=sources,proto3" json:"sources,omitempty"`
+	IdentityRules        []string                        `protobuf:"bytes,22,rep,name=identity_rules,json=identityRules,proto3" json:"identity_rules,omitempty"`
+	CsvFile              string                          `protobuf:"bytes,23,opt,name=csv_file,json=csvFile,proto3" json:"csv_file,omitempty"`
+}
+
+func (x *L8TopologyDiscovery) Reset() {
+	*x = L8TopologyDiscovery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_topology_proto_msgTypes[2]
+		mi := &file_topology_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *L8TopologyNode) String() string {
+func (x *L8TopologyDiscovery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*L8TopologyNode) ProtoMessage() {}
+func (*L8TopologyDiscovery) ProtoMessage() {}
 
-func (x *L8TopologyNode) ProtoReflect() protoreflect.Message {
-	mi := &file_topology_proto_msgTypes[2]
+func (x *L8TopologyDiscovery) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,75 +2393,203 @@ func (x *L8TopologyNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use L8TopologyNode.ProtoReflect.Descriptor instead.
-func (*L8TopologyNode) Descriptor() ([]byte, []int) {
-	return file_topology_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use L8TopologyDiscovery.ProtoReflect.Descriptor instead.
+func (*L8TopologyDiscovery) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *L8TopologyNode) GetNodeId() string {
+func (x *L8TopologyDiscovery) GetName() string {
 	if x != nil {
-		return x.NodeId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *L8TopologyNode) GetName() string {
+func (x *L8TopologyDiscovery) GetServiceName() string {
 	if x != nil {
-		return x.Name
+		return x.ServiceName
 	}
 	return ""
 }
 
-func (x *L8TopologyNode) GetLocation() string {
+func (x *L8TopologyDiscovery) GetServiceArea() int32 {
 	if x != nil {
-		return x.Location
+		return x.ServiceArea
+	}
+	return 0
+}
+
+func (x *L8TopologyDiscovery) GetInventoryServiceName() string {
+	if x != nil {
+		return x.InventoryServiceName
 	}
 	return ""
 }
 
-func (x *L8TopologyNode) GetCount() int32 {
+func (x *L8TopologyDiscovery) GetInventoryServiceArea() int32 {
 	if x != nil {
-		return x.Count
+		return x.InventoryServiceArea
 	}
 	return 0
 }
 
-func (x *L8TopologyNode) GetType() L8TopologyNodeType {
+func (x *L8TopologyDiscovery) GetQuery() string {
 	if x != nil {
-		return x.Type
+		return x.Query
 	}
-	return L8TopologyNodeType_Generic
+	return ""
 }
 
-type L8TopologyLocation struct {
+func (x *L8TopologyDiscovery) GetModelTypeName() string {
+	if x != nil {
+		return x.ModelTypeName
+	}
+	return ""
+}
+
+func (x *L8TopologyDiscovery) GetIdPath() string {
+	if x != nil {
+		return x.IdPath
+	}
+	return ""
+}
+
+func (x *L8TopologyDiscovery) GetNamePath() string {
+	if x != nil {
+		return x.NamePath
+	}
+	return ""
+}
+
+func (x *L8TopologyDiscovery) GetLocationPath() string {
+	if x != nil {
+		return x.LocationPath
+	}
+	return ""
+}
+
+func (x *L8TopologyDiscovery) GetTypePath() string {
+	if x != nil {
+		return x.TypePath
+	}
+	return ""
+}
+
+func (x *L8TopologyDiscovery) GetNodeTypes() map[string]L8TopologyNodeType {
+	if x != nil {
+		return x.NodeTypes
+	}
+	return nil
+}
+
+func (x *L8TopologyDiscovery) GetStatusPath() string {
+	if x != nil {
+		return x.StatusPath
+	}
+	return ""
+}
+
+func (x *L8TopologyDiscovery) GetNodeStatuses() map[string]L8TopologyNodeStatus {
+	if x != nil {
+		return x.NodeStatuses
+	}
+	return nil
+}
+
+func (x *L8TopologyDiscovery) GetLatitudePath() string {
+	if x != nil {
+		return x.LatitudePath
+	}
+	return ""
+}
+
+func (x *L8TopologyDiscovery) GetLongitudePath() string {
+	if x != nil {
+		return x.LongitudePath
+	}
+	return ""
+}
+
+func (x *L8TopologyDiscovery) GetLinkRule() *L8TopologyLinkRule {
+	if x != nil {
+		return x.LinkRule
+	}
+	return nil
+}
+
+func (x *L8TopologyDiscovery) GetProjection() string {
+	if x != nil {
+		return x.Projection
+	}
+	return ""
+}
+
+func (x *L8TopologyDiscovery) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *L8TopologyDiscovery) GetAttributePaths() map[string]string {
+	if x != nil {
+		return x.AttributePaths
+	}
+	return nil
+}
+
+func (x *L8TopologyDiscovery) GetSources() []*L8TopologyDiscovery {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *L8TopologyDiscovery) GetIdentityRules() []string {
+	if x != nil {
+		return x.IdentityRules
+	}
+	return nil
+}
+
+func (x *L8TopologyDiscovery) GetCsvFile() string {
+	if x != nil {
+		return x.CsvFile
+	}
+	return ""
+}
+
+type L8TopologyLinkRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Location  string  `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	Longitude float32 `protobuf:"fixed32,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Latitude  float32 `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	SvgX      float32 `protobuf:"fixed32,4,opt,name=svg_x,json=svgX,proto3" json:"svg_x,omitempty"`
-	SvgY      float32 `protobuf:"fixed32,5,opt,name=svg_y,json=svgY,proto3" json:"svg_y,omitempty"`
+	AsidePath    string `protobuf:"bytes,1,opt,name=aside_path,json=asidePath,proto3" json:"aside_path,omitempty"`
+	ZsidePath    string `protobuf:"bytes,2,opt,name=zside_path,json=zsidePath,proto3" json:"zside_path,omitempty"`
+	StatusPath   string `protobuf:"bytes,3,opt,name=status_path,json=statusPath,proto3" json:"status_path,omitempty"`
+	UpValue      string `protobuf:"bytes,4,opt,name=up_value,json=upValue,proto3" json:"up_value,omitempty"`
+	SpeedPath    string `protobuf:"bytes,5,opt,name=speed_path,json=speedPath,proto3" json:"speed_path,omitempty"`
+	LagPath      string `protobuf:"bytes,6,opt,name=lag_path,json=lagPath,proto3" json:"lag_path,omitempty"`
+	PortNamePath string `protobuf:"bytes,7,opt,name=port_name_path,json=portNamePath,proto3" json:"port_name_path,omitempty"`
 }
 
-func (x *L8TopologyLocation) Reset() {
-	*x = L8TopologyLocation{}
+func (x *L8TopologyLinkRule) Reset() {
+	*x = L8TopologyLinkRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_topology_proto_msgTypes[3]
+		mi := &file_topology_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *L8TopologyLocation) String() string {
+func (x *L8TopologyLinkRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*L8TopologyLocation) ProtoMessage() {}
+func (*L8TopologyLinkRule) ProtoMessage() {}
 
-func (x *L8TopologyLocation) ProtoReflect() protoreflect.Message {
-	mi := &file_topology_proto_msgTypes[3]
+func (x *L8TopologyLinkRule) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,150 +2600,254 @@ func (x *L8TopologyLocation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use L8TopologyLocation.ProtoReflect.Descriptor instead.
-func (*L8TopologyLocation) Descriptor() ([]byte, []int) {
-	return file_topology_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use L8TopologyLinkRule.ProtoReflect.Descriptor instead.
+func (*L8TopologyLinkRule) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *L8TopologyLocation) GetLocation() string {
+func (x *L8TopologyLinkRule) GetAsidePath() string {
 	if x != nil {
-		return x.Location
+		return x.AsidePath
 	}
 	return ""
 }
 
-func (x *L8TopologyLocation) GetLongitude() float32 {
+func (x *L8TopologyLinkRule) GetZsidePath() string {
 	if x != nil {
-		return x.Longitude
+		return x.ZsidePath
 	}
-	return 0
+	return ""
 }
 
-func (x *L8TopologyLocation) GetLatitude() float32 {
+func (x *L8TopologyLinkRule) GetStatusPath() string {
 	if x != nil {
-		return x.Latitude
+		return x.StatusPath
 	}
-	return 0
+	return ""
 }
 
-func (x *L8TopologyLocation) GetSvgX() float32 {
+func (x *L8TopologyLinkRule) GetUpValue() string {
 	if x != nil {
-		return x.SvgX
+		return x.UpValue
 	}
-	return 0
+	return ""
 }
 
-func (x *L8TopologyLocation) GetSvgY() float32 {
+func (x *L8TopologyLinkRule) GetSpeedPath() string {
 	if x != nil {
-		return x.SvgY
+		return x.SpeedPath
 	}
-	return 0
+	return ""
 }
 
-type L8TopologyLink struct {
+func (x *L8TopologyLinkRule) GetLagPath() string {
+	if x != nil {
+		return x.LagPath
+	}
+	return ""
+}
+
+func (x *L8TopologyLinkRule) GetPortNamePath() string {
+	if x != nil {
+		return x.PortNamePath
+	}
+	return ""
+}
+
+type L8RoutingDeviceList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LinkId    string                  `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
-	Aside     string                  `protobuf:"bytes,2,opt,name=aside,proto3" json:"aside,omitempty"`
-	Zside     string                  `protobuf:"bytes,3,opt,name=zside,proto3" json:"zside,omitempty"`
-	Direction L8TopologyLinkDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=l8topo.L8TopologyLinkDirection" json:"direction,omitempty"`
-	Status    L8TopologyLinkStatus    `protobuf:"varint,5,opt,name=status,proto3,enum=l8topo.L8TopologyLinkStatus" json:"status,omitempty"`
+	List []*L8RoutingDevice `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *L8TopologyLink) Reset() {
-	*x = L8TopologyLink{}
+func (x *L8RoutingDeviceList) Reset() {
+	*x = L8RoutingDeviceList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_topology_proto_msgTypes[4]
+		mi := &file_topology_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *L8TopologyLink) String() string {
+func (x *L8RoutingDeviceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L8RoutingDeviceList) ProtoMessage() {}
+
+func (x *L8RoutingDeviceList) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8RoutingDeviceList.ProtoReflect.Descriptor instead.
+func (*L8RoutingDeviceList) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *L8RoutingDeviceList) GetList() []*L8RoutingDevice {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type L8RoutingDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location     string                `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Latitude     float32               `protobuf:"fixed32,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude    float32               `protobuf:"fixed32,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RouterId     string                `protobuf:"bytes,6,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	IpAddress    string                `protobuf:"bytes,7,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	SerialNumber string                `protobuf:"bytes,8,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	Adjacencies  []*L8RoutingAdjacency `protobuf:"bytes,9,rep,name=adjacencies,proto3" json:"adjacencies,omitempty"`
+}
+
+func (x *L8RoutingDevice) Reset() {
+	*x = L8RoutingDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topology_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *L8RoutingDevice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*L8TopologyLink) ProtoMessage() {}
-
-func (x *L8TopologyLink) ProtoReflect() protoreflect.Message {
-	mi := &file_topology_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (*L8RoutingDevice) ProtoMessage() {}
+
+func (x *L8RoutingDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L8RoutingDevice.ProtoReflect.Descriptor instead.
+func (*L8RoutingDevice) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *L8RoutingDevice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *L8RoutingDevice) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *L8RoutingDevice) GetLocation() string {
+	if x != nil {
+		return x.Location
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use L8TopologyLink.ProtoReflect.Descriptor instead.
-func (*L8TopologyLink) Descriptor() ([]byte, []int) {
-	return file_topology_proto_rawDescGZIP(), []int{4}
+func (x *L8RoutingDevice) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
 }
 
-func (x *L8TopologyLink) GetLinkId() string {
+func (x *L8RoutingDevice) GetLongitude() float32 {
 	if x != nil {
-		return x.LinkId
+		return x.Longitude
 	}
-	return ""
+	return 0
 }
 
-func (x *L8TopologyLink) GetAside() string {
+func (x *L8RoutingDevice) GetRouterId() string {
 	if x != nil {
-		return x.Aside
+		return x.RouterId
 	}
 	return ""
 }
 
-func (x *L8TopologyLink) GetZside() string {
+func (x *L8RoutingDevice) GetIpAddress() string {
 	if x != nil {
-		return x.Zside
+		return x.IpAddress
 	}
 	return ""
 }
 
-func (x *L8TopologyLink) GetDirection() L8TopologyLinkDirection {
+func (x *L8RoutingDevice) GetSerialNumber() string {
 	if x != nil {
-		return x.Direction
+		return x.SerialNumber
 	}
-	return L8TopologyLinkDirection_InvalidDirection
+	return ""
 }
 
-func (x *L8TopologyLink) GetStatus() L8TopologyLinkStatus {
+func (x *L8RoutingDevice) GetAdjacencies() []*L8RoutingAdjacency {
 	if x != nil {
-		return x.Status
+		return x.Adjacencies
 	}
-	return L8TopologyLinkStatus_InvalidStatus
+	return nil
 }
 
-type L8TopologyMetadataList struct {
+type L8RoutingAdjacency struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	List []*L8TopologyMetadata `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
-}
-
-func (x *L8TopologyMetadataList) Reset() {
-	*x = L8TopologyMetadataList{}
+	Protocol        L8RoutingProtocol `protobuf:"varint,1,opt,name=protocol,proto3,enum=l8topo.L8RoutingProtocol" json:"protocol,omitempty"`
+	LocalId         string            `protobuf:"bytes,2,opt,name=local_id,json=localId,proto3" json:"local_id,omitempty"`
+	LocalAddress    string            `protobuf:"bytes,3,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	InterfaceName   string            `protobuf:"bytes,4,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	NeighborId      string            `protobuf:"bytes,5,opt,name=neighbor_id,json=neighborId,proto3" json:"neighbor_id,omitempty"`
+	NeighborAddress string            `protobuf:"bytes,6,opt,name=neighbor_address,json=neighborAddress,proto3" json:"neighbor_address,omitempty"`
+	Area            string            `protobuf:"bytes,7,opt,name=area,proto3" json:"area,omitempty"`
+	Level           int32             `protobuf:"varint,8,opt,name=level,proto3" json:"level,omitempty"`
+	LocalAs         uint32            `protobuf:"varint,9,opt,name=local_as,json=localAs,proto3" json:"local_as,omitempty"`
+	NeighborAs      uint32            `protobuf:"varint,10,opt,name=neighbor_as,json=neighborAs,proto3" json:"neighbor_as,omitempty"`
+	Metric          uint32            `protobuf:"varint,11,opt,name=metric,proto3" json:"metric,omitempty"`
+	State           string            `protobuf:"bytes,12,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *L8RoutingAdjacency) Reset() {
+	*x = L8RoutingAdjacency{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_topology_proto_msgTypes[5]
+		mi := &file_topology_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *L8TopologyMetadataList) String() string {
+func (x *L8RoutingAdjacency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*L8TopologyMetadataList) ProtoMessage() {}
+func (*L8RoutingAdjacency) ProtoMessage() {}
 
-func (x *L8TopologyMetadataList) ProtoReflect() protoreflect.Message {
-	mi := &file_topology_proto_msgTypes[5]
+func (x *L8RoutingAdjacency) ProtoReflect() protoreflect.Message {
+	mi := &file_topology_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,198 +2858,727 @@ func (x *L8TopologyMetadataList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use L8TopologyMetadataList.ProtoReflect.Descriptor instead.
-func (*L8TopologyMetadataList) Descriptor() ([]byte, []int) {
-	return file_topology_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use L8RoutingAdjacency.ProtoReflect.Descriptor instead.
+func (*L8RoutingAdjacency) Descriptor() ([]byte, []int) {
+	return file_topology_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *L8TopologyMetadataList) GetList() []*L8TopologyMetadata {
+func (x *L8RoutingAdjacency) GetProtocol() L8RoutingProtocol {
 	if x != nil {
-		return x.List
+		return x.Protocol
 	}
-	return nil
+	return L8RoutingProtocol_InvalidRoutingProtocol
 }
 
-type L8TopologyMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *L8RoutingAdjacency) GetLocalId() string {
+	if x != nil {
+		return x.LocalId
+	}
+	return ""
+}
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ServiceName string `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
-	ServiceArea int32  `protobuf:"varint,3,opt,name=serviceArea,proto3" json:"serviceArea,omitempty"`
+func (x *L8RoutingAdjacency) GetLocalAddress() string {
+	if x != nil {
+		return x.LocalAddress
+	}
+	return ""
 }
 
-func (x *L8TopologyMetadata) Reset() {
-	*x = L8TopologyMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_topology_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *L8RoutingAdjacency) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
 	}
+	return ""
 }
 
-func (x *L8TopologyMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *L8RoutingAdjacency) GetNeighborId() string {
+	if x != nil {
+		return x.NeighborId
+	}
+	return ""
 }
 
-func (*L8TopologyMetadata) ProtoMessage() {}
+func (x *L8RoutingAdjacency) GetNeighborAddress() string {
+	if x != nil {
+		return x.NeighborAddress
+	}
+	return ""
+}
 
-func (x *L8TopologyMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_topology_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *L8RoutingAdjacency) GetArea() string {
+	if x != nil {
+		return x.Area
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use L8TopologyMetadata.ProtoReflect.Descriptor instead.
-func (*L8TopologyMetadata) Descriptor() ([]byte, []int) {
-	return file_topology_proto_rawDescGZIP(), []int{6}
+func (x *L8RoutingAdjacency) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
 }
 
-func (x *L8TopologyMetadata) GetName() string {
+func (x *L8RoutingAdjacency) GetLocalAs() uint32 {
 	if x != nil {
-		return x.Name
+		return x.LocalAs
 	}
-	return ""
+	return 0
 }
 
-func (x *L8TopologyMetadata) GetServiceName() string {
+func (x *L8RoutingAdjacency) GetNeighborAs() uint32 {
 	if x != nil {
-		return x.ServiceName
+		return x.NeighborAs
 	}
-	return ""
+	return 0
 }
 
-func (x *L8TopologyMetadata) GetServiceArea() int32 {
+func (x *L8RoutingAdjacency) GetMetric() uint32 {
 	if x != nil {
-		return x.ServiceArea
+		return x.Metric
 	}
 	return 0
 }
 
+func (x *L8RoutingAdjacency) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
 var File_topology_proto protoreflect.FileDescriptor
 
 var file_topology_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x06, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x22, 0x7f, 0x0a, 0x0f, 0x4c, 0x38, 0x54, 0x6f,
-	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x6c,
-	0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x38,
-	0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c,
-	0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x0c, 0x0a,
-	0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x31, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x78, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x79, 0x31, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x79, 0x31, 0x22, 0xc9, 0x03, 0x0a, 0x0a, 0x4c, 0x38,
-	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x05,
-	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x38,
-	0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65,
-	0x73, 0x12, 0x33, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x12, 0x06, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x22, 0x73, 0x0a, 0x15, 0x4c, 0x38, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x7a, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x5a, 0x6f, 0x6f, 0x6d, 0x12, 0x3f, 0x0a, 0x0b,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb3, 0x0c,
+	0x0a, 0x0f, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x30, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x06, 0x6c, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01,
+	0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x78, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x78, 0x31, 0x12,
+	0x0e, 0x0a, 0x02, 0x79, 0x31, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x79, 0x31, 0x12,
+	0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x7a, 0x6f, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x7a, 0x6f,
+	0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e,
+	0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x41, 0x0a, 0x0d, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0d, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x1d, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f,
-	0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x38, 0x74, 0x6f,
-	0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x50, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x6c, 0x6f, 0x67, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x0c, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5d,
+	0x0a, 0x12, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6c, 0x38, 0x74,
+	0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x6a, 0x0a,
+	0x17, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x15, 0x6c, 0x69, 0x6e, 0x6b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x74, 0x6d,
+	0x61, 0x70, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x74, 0x6d, 0x61,
+	0x70, 0x12, 0x33, 0x0a, 0x15, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x14, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28,
+	0x02, 0x52, 0x10, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61,
+	0x6e, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d,
+	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x2b, 0x0a, 0x11,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x70,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x20, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x21, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d,
+	0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x12, 0x20, 0x0a,
+	0x0c, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x22, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x73, 0x12,
+	0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x70, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x23, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x24, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x72, 0x61, 0x77, 0x1a, 0x44, 0x0a, 0x16, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x48, 0x0a, 0x1a, 0x4c, 0x69, 0x6e,
+	0x6b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x9d, 0x07, 0x0a, 0x0a, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c,
+	0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x6c,
+	0x69, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x38, 0x74,
+	0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73,
+	0x12, 0x3f, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6e,
+	0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x66,
+	0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x6c, 0x61, 0x6e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x4e, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x10, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x50, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x50, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e,
-	0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x50, 0x0a, 0x0a, 0x4c, 0x69, 0x6e,
-	0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70,
-	0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e, 0x6b,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c,
+	0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x8b, 0x03, 0x0a, 0x19, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x75, 0x6e,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0xb6, 0x03, 0x0a, 0x0e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c,
+	0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x1a, 0x3d, 0x0a, 0x0f, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xde, 0x05, 0x0a, 0x12, 0x4c,
+	0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x76, 0x67, 0x5f, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x73, 0x76, 0x67, 0x58, 0x12, 0x13, 0x0a, 0x05,
+	0x73, 0x76, 0x67, 0x5f, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x73, 0x76, 0x67,
+	0x59, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x78, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x58, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x08, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x59, 0x12, 0x4a, 0x0a, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x38,
+	0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x58, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x79, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x59, 0x12, 0x28, 0x0a, 0x10,
+	0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x6c, 0x61,
+	0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f,
+	0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0e, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x57, 0x69, 0x64, 0x74, 0x68,
+	0x12, 0x2a, 0x0a, 0x11, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x66, 0x6c, 0x6f,
+	0x6f, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x3d, 0x0a, 0x0f,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9c, 0x08, 0x0a, 0x0e,
+	0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x17,
+	0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x69, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x7a, 0x73, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x7a, 0x73,
+	0x69, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e,
+	0x4c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x62, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x45, 0x0a, 0x0e, 0x61, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x38, 0x74, 0x6f,
+	0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e,
+	0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0d, 0x61, 0x73, 0x69, 0x64, 0x65,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x0e, 0x7a, 0x73, 0x69, 0x64,
+	0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x0d, 0x7a, 0x73, 0x69, 0x64, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
 	0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
-	0x67, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9f, 0x01, 0x0a, 0x0e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f,
-	0x6c, 0x6f, 0x67, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c,
-	0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x4c, 0x38, 0x54, 0x6f,
-	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
-	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f,
-	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c,
-	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
-	0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
-	0x74, 0x75, 0x64, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x76, 0x67, 0x5f, 0x78, 0x18, 0x04, 0x20,
+	0x67, 0x79, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x10, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x54, 0x0a, 0x16, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x61,
+	0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x11,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x14, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x73, 0x69, 0x64,
+	0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x16, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x7a, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x38, 0x74,
+	0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69,
+	0x6e, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x14, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x5a, 0x73, 0x69, 0x64, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x1a, 0x3d, 0x0a, 0x0f, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x02, 0x0a, 0x16, 0x4c,
+	0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x72, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x6d, 0x74, 0x75, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x69, 0x6e, 0x55,
+	0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x75,
+	0x74, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x69, 0x6e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0c, 0x6f, 0x75, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x22, 0x2d, 0x0a,
+	0x0f, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c,
+	0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x22, 0x8f, 0x01, 0x0a,
+	0x18, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x50, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x06, 0x6c, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x76, 0x67, 0x5f, 0x78, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x02, 0x52, 0x04, 0x73, 0x76, 0x67, 0x58, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x76, 0x67,
-	0x5f, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x73, 0x76, 0x67, 0x59, 0x22, 0xca,
-	0x01, 0x0a, 0x0e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e,
-	0x6b, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73,
-	0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x69, 0x64, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x7a, 0x73, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x7a, 0x73, 0x69, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x38, 0x74, 0x6f,
-	0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e,
-	0x6b, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c,
-	0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x48, 0x0a, 0x16, 0x4c,
-	0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54,
-	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x6c, 0x0a, 0x12, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c,
-	0x6f, 0x67, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
-	0x72, 0x65, 0x61, 0x2a, 0x60, 0x0a, 0x10, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67,
-	0x79, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x48, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x63, 0x61, 0x6c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x69, 0x72, 0x63, 0x75,
-	0x6c, 0x61, 0x72, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x61, 0x64, 0x69, 0x61, 0x6c, 0x10,
-	0x03, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x10, 0x04, 0x2a, 0xab, 0x01, 0x0a, 0x12, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f,
-	0x6c, 0x6f, 0x67, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x57, 0x49,
-	0x54, 0x43, 0x48, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10,
-	0x02, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x41, 0x47, 0x47,
-	0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49,
-	0x52, 0x45, 0x57, 0x41, 0x4c, 0x4c, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f, 0x41, 0x44,
-	0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x52, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x41,
-	0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x0a, 0x0a,
-	0x06, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x07, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x4f,
-	0x52, 0x41, 0x47, 0x45, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41,
-	0x59, 0x10, 0x09, 0x2a, 0x66, 0x0a, 0x17, 0x4c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67,
-	0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
-	0x0a, 0x10, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x73, 0x69, 0x64, 0x65, 0x54, 0x6f, 0x5a,
-	0x73, 0x69, 0x64, 0x65, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x5a, 0x73, 0x69, 0x64, 0x65, 0x54,
-	0x6f, 0x41, 0x73, 0x69, 0x64, 0x65, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x69, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x10, 0x03, 0x2a, 0x48, 0x0a, 0x14, 0x4c,
-	0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x70, 0x10, 0x01, 0x12, 0x08,
-	0x0a, 0x04, 0x44, 0x6f, 0x77, 0x6e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x61, 0x6c, 0x10, 0x03, 0x42, 0x2c, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x42, 0x06, 0x4c, 0x38, 0x54, 0x6f, 0x70,
-	0x6f, 0x50, 0x01, 0x5a, 0x0e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6c, 0x38, 0x74,
-	0x6f, 0x70, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x73, 0x76, 0x67, 0x59, 0x22, 0x54,
+	0x0a, 0x1c, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x50, 0x69, 0x6e, 0x6e,
+	0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x34,
+	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c,
+	0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x16, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c,
+	0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xb8,
+	0x02, 0x0a, 0x12, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x22, 0x4a, 0x0a, 0x17, 0x4c, 0x38, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xe7, 0x09, 0x0a, 0x13, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x61, 0x72, 0x65, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x76, 0x65, 0x6e,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a,
+	0x16, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x69,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x72, 0x65, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x69, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x61, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x79, 0x70, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x49, 0x0a, 0x0a, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x52, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c,
+	0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x25,
+	0x0a, 0x0e, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70,
+	0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x58, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x38, 0x74, 0x6f,
+	0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x38,
+	0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x73, 0x76, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x73, 0x76, 0x46,
+	0x69, 0x6c, 0x65, 0x1a, 0x58, 0x0a, 0x0e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e,
+	0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5d, 0x0a,
+	0x11, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xee, 0x01, 0x0a, 0x12, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x69, 0x64, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x69, 0x64,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x7a, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x7a, 0x73, 0x69, 0x64, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x65, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x19, 0x0a, 0x08, 0x6c, 0x61, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6c, 0x61, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x22, 0x42, 0x0a, 0x13, 0x4c, 0x38, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x2e, 0x4c,
+	0x38, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x22, 0xaa, 0x02, 0x0a, 0x0f, 0x4c, 0x38, 0x52, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x0b, 0x61, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70,
+	0x6f, 0x2e, 0x4c, 0x38, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x6a, 0x61, 0x63,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x0b, 0x61, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x22, 0x92, 0x03, 0x0a, 0x12, 0x4c, 0x38, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x41,
+	0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6c, 0x38, 0x74,
+	0x6f, 0x70, 0x6f, 0x2e, 0x4c, 0x38, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x5f, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x41, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2a, 0x70, 0x0a, 0x10, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x48, 0x69, 0x65, 0x72,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x63, 0x61, 0x6c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x69,
+	0x72, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x61, 0x64, 0x69,
+	0x61, 0x6c, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x6f,
+	0x72, 0x5f, 0x50, 0x6c, 0x61, 0x6e, 0x10, 0x05, 0x2a, 0x77, 0x0a, 0x15, 0x4c, 0x38, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x69, 0x74, 0x65, 0x10, 0x02, 0x12,
+	0x08, 0x0a, 0x04, 0x43, 0x69, 0x74, 0x79, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x10,
+	0x06, 0x2a, 0xab, 0x01, 0x0a, 0x12, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x69, 0x63, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x17, 0x0a,
+	0x13, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x52, 0x45, 0x57, 0x41,
+	0x4c, 0x4c, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x42, 0x41, 0x4c,
+	0x41, 0x4e, 0x43, 0x45, 0x52, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x52,
+	0x56, 0x45, 0x52, 0x10, 0x07, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45,
+	0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41, 0x59, 0x10, 0x09, 0x2a,
+	0x58, 0x0a, 0x14, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4e, 0x6f, 0x64,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x6f,
+	0x64, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x10, 0x03, 0x2a, 0xe5, 0x01, 0x0a, 0x1c, 0x4c, 0x38,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x10,
+	0x00, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x78, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x02, 0x12, 0x18,
+	0x0a, 0x14, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x16, 0x0a,
+	0x12, 0x53, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x75, 0x7a, 0x7a, 0x79, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x10,
+	0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x10,
+	0x08, 0x2a, 0x76, 0x0a, 0x16, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x47,
+	0x65, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x61, 0x63, 0x6b, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x2a, 0x66, 0x0a, 0x17, 0x4c, 0x38, 0x74,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x73,
+	0x69, 0x64, 0x65, 0x54, 0x6f, 0x5a, 0x73, 0x69, 0x64, 0x65, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
+	0x5a, 0x73, 0x69, 0x64, 0x65, 0x54, 0x6f, 0x41, 0x73, 0x69, 0x64, 0x65, 0x10, 0x02, 0x12, 0x11,
+	0x0a, 0x0d, 0x42, 0x69, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x10,
+	0x03, 0x2a, 0x48, 0x0a, 0x14, 0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4c,
+	0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02,
+	0x55, 0x70, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x6f, 0x77, 0x6e, 0x10, 0x02, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x10, 0x03, 0x2a, 0x8d, 0x01, 0x0a, 0x1a,
+	0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x6f, 0x77, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d,
+	0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x02, 0x12, 0x13, 0x0a,
+	0x0f, 0x48, 0x69, 0x67, 0x68, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x74,
+	0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x2a, 0x6f, 0x0a, 0x10, 0x4c,
+	0x38, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x19, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x2a, 0x4c, 0x0a, 0x11,
+	0x4c, 0x38, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x4f, 0x53, 0x50, 0x46, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x53, 0x49, 0x53, 0x10,
+	0x02, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x47, 0x50, 0x10, 0x03, 0x42, 0x2c, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x42, 0x06,
+	0x4c, 0x38, 0x54, 0x6f, 0x70, 0x6f, 0x50, 0x01, 0x5a, 0x0e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x6c, 0x38, 0x74, 0x6f, 0x70, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -877,41 +3593,102 @@ func file_topology_proto_rawDescGZIP() []byte {
 	return file_topology_proto_rawDescData
 }
 
-var file_topology_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_topology_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_topology_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_topology_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_topology_proto_goTypes = []interface{}{
-	(L8TopologyLayout)(0),          // 0: l8topo.L8TopologyLayout
-	(L8TopologyNodeType)(0),        // 1: l8topo.L8TopologyNodeType
-	(L8TopologyLinkDirection)(0),   // 2: l8topo.L8topologyLinkDirection
-	(L8TopologyLinkStatus)(0),      // 3: l8topo.L8TopologyLinkStatus
-	(*L8TopologyQuery)(nil),        // 4: l8topo.L8TopologyQuery
-	(*L8Topology)(nil),             // 5: l8topo.L8Topology
-	(*L8TopologyNode)(nil),         // 6: l8topo.L8TopologyNode
-	(*L8TopologyLocation)(nil),     // 7: l8topo.L8TopologyLocation
-	(*L8TopologyLink)(nil),         // 8: l8topo.L8TopologyLink
-	(*L8TopologyMetadataList)(nil), // 9: l8topo.L8TopologyMetadataList
-	(*L8TopologyMetadata)(nil),     // 10: l8topo.L8TopologyMetadata
-	nil,                            // 11: l8topo.L8Topology.NodesEntry
-	nil,                            // 12: l8topo.L8Topology.LinksEntry
-	nil,                            // 13: l8topo.L8Topology.LocationsEntry
+	(L8TopologyLayout)(0),                // 0: l8topo.L8TopologyLayout
+	(L8TopologyAggregation)(0),           // 1: l8topo.L8TopologyAggregation
+	(L8TopologyNodeType)(0),              // 2: l8topo.L8TopologyNodeType
+	(L8TopologyNodeStatus)(0),            // 3: l8topo.L8TopologyNodeStatus
+	(L8TopologyLocationResolution)(0),    // 4: l8topo.L8TopologyLocationResolution
+	(L8TopologyLocationKind)(0),          // 5: l8topo.L8TopologyLocationKind
+	(L8TopologyLinkDirection)(0),         // 6: l8topo.L8topologyLinkDirection
+	(L8TopologyLinkStatus)(0),            // 7: l8topo.L8TopologyLinkStatus
+	(L8TopologyUtilizationLevel)(0),      // 8: l8topo.L8TopologyUtilizationLevel
+	(L8TopologyStatus)(0),                // 9: l8topo.L8TopologyStatus
+	(L8RoutingProtocol)(0),               // 10: l8topo.L8RoutingProtocol
+	(*L8TopologyDetailLevel)(nil),        // 11: l8topo.L8TopologyDetailLevel
+	(*L8TopologyQuery)(nil),              // 12: l8topo.L8TopologyQuery
+	(*L8Topology)(nil),                   // 13: l8topo.L8Topology
+	(*L8TopologyDiscoveryReport)(nil),    // 14: l8topo.L8TopologyDiscoveryReport
+	(*L8TopologyNode)(nil),               // 15: l8topo.L8TopologyNode
+	(*L8TopologyLocation)(nil),           // 16: l8topo.L8TopologyLocation
+	(*L8TopologyLink)(nil),               // 17: l8topo.L8TopologyLink
+	(*L8TopologyLinkEndpoint)(nil),       // 18: l8topo.L8TopologyLinkEndpoint
+	(*L8TopologyPoint)(nil),              // 19: l8topo.L8TopologyPoint
+	(*L8TopologyPinnedPosition)(nil),     // 20: l8topo.L8TopologyPinnedPosition
+	(*L8TopologyPinnedPositionList)(nil), // 21: l8topo.L8TopologyPinnedPositionList
+	(*L8TopologyMetadataList)(nil),       // 22: l8topo.L8TopologyMetadataList
+	(*L8TopologyMetadata)(nil),           // 23: l8topo.L8TopologyMetadata
+	(*L8TopologyDiscoveryList)(nil),      // 24: l8topo.L8TopologyDiscoveryList
+	(*L8TopologyDiscovery)(nil),          // 25: l8topo.L8TopologyDiscovery
+	(*L8TopologyLinkRule)(nil),           // 26: l8topo.L8TopologyLinkRule
+	(*L8RoutingDeviceList)(nil),          // 27: l8topo.L8RoutingDeviceList
+	(*L8RoutingDevice)(nil),              // 28: l8topo.L8RoutingDevice
+	(*L8RoutingAdjacency)(nil),           // 29: l8topo.L8RoutingAdjacency
+	nil,                                  // 30: l8topo.L8TopologyQuery.AttributePatternsEntry
+	nil,                                  // 31: l8topo.L8TopologyQuery.LinkAttributePatternsEntry
+	nil,                                  // 32: l8topo.L8Topology.NodesEntry
+	nil,                                  // 33: l8topo.L8Topology.LinksEntry
+	nil,                                  // 34: l8topo.L8Topology.LocationsEntry
+	nil,                                  // 35: l8topo.L8TopologyNode.AttributesEntry
+	nil,                                  // 36: l8topo.L8TopologyLocation.AttributesEntry
+	nil,                                  // 37: l8topo.L8TopologyLink.AttributesEntry
+	nil,                                  // 38: l8topo.L8TopologyDiscovery.NodeTypesEntry
+	nil,                                  // 39: l8topo.L8TopologyDiscovery.NodeStatusesEntry
+	nil,                                  // 40: l8topo.L8TopologyDiscovery.AttributePathsEntry
 }
 var file_topology_proto_depIdxs = []int32{
-	0,  // 0: l8topo.L8TopologyQuery.layout:type_name -> l8topo.L8TopologyLayout
-	11, // 1: l8topo.L8Topology.nodes:type_name -> l8topo.L8Topology.NodesEntry
-	12, // 2: l8topo.L8Topology.links:type_name -> l8topo.L8Topology.LinksEntry
-	13, // 3: l8topo.L8Topology.locations:type_name -> l8topo.L8Topology.LocationsEntry
-	1,  // 4: l8topo.L8TopologyNode.type:type_name -> l8topo.L8TopologyNodeType
-	2,  // 5: l8topo.L8TopologyLink.direction:type_name -> l8topo.L8topologyLinkDirection
-	3,  // 6: l8topo.L8TopologyLink.status:type_name -> l8topo.L8TopologyLinkStatus
-	10, // 7: l8topo.L8TopologyMetadataList.list:type_name -> l8topo.L8TopologyMetadata
-	6,  // 8: l8topo.L8Topology.NodesEntry.value:type_name -> l8topo.L8TopologyNode
-	8,  // 9: l8topo.L8Topology.LinksEntry.value:type_name -> l8topo.L8TopologyLink
-	7,  // 10: l8topo.L8Topology.LocationsEntry.value:type_name -> l8topo.L8TopologyLocation
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	1,  // 0: l8topo.L8TopologyDetailLevel.aggregation:type_name -> l8topo.L8TopologyAggregation
+	0,  // 1: l8topo.L8TopologyQuery.layout:type_name -> l8topo.L8TopologyLayout
+	2,  // 2: l8topo.L8TopologyQuery.node_types:type_name -> l8topo.L8TopologyNodeType
+	7,  // 3: l8topo.L8TopologyQuery.link_statuses:type_name -> l8topo.L8TopologyLinkStatus
+	1,  // 4: l8topo.L8TopologyQuery.aggregation:type_name -> l8topo.L8TopologyAggregation
+	11, // 5: l8topo.L8TopologyQuery.detail_levels:type_name -> l8topo.L8TopologyDetailLevel
+	30, // 6: l8topo.L8TopologyQuery.attribute_patterns:type_name -> l8topo.L8TopologyQuery.AttributePatternsEntry
+	31, // 7: l8topo.L8TopologyQuery.link_attribute_patterns:type_name -> l8topo.L8TopologyQuery.LinkAttributePatternsEntry
+	32, // 8: l8topo.L8Topology.nodes:type_name -> l8topo.L8Topology.NodesEntry
+	33, // 9: l8topo.L8Topology.links:type_name -> l8topo.L8Topology.LinksEntry
+	34, // 10: l8topo.L8Topology.locations:type_name -> l8topo.L8Topology.LocationsEntry
+	14, // 11: l8topo.L8Topology.discovery_reports:type_name -> l8topo.L8TopologyDiscoveryReport
+	2,  // 12: l8topo.L8TopologyNode.type:type_name -> l8topo.L8TopologyNodeType
+	3,  // 13: l8topo.L8TopologyNode.status:type_name -> l8topo.L8TopologyNodeStatus
+	35, // 14: l8topo.L8TopologyNode.attributes:type_name -> l8topo.L8TopologyNode.AttributesEntry
+	36, // 15: l8topo.L8TopologyLocation.attributes:type_name -> l8topo.L8TopologyLocation.AttributesEntry
+	4,  // 16: l8topo.L8TopologyLocation.resolution:type_name -> l8topo.L8TopologyLocationResolution
+	5,  // 17: l8topo.L8TopologyLocation.kind:type_name -> l8topo.L8TopologyLocationKind
+	6,  // 18: l8topo.L8TopologyLink.direction:type_name -> l8topo.L8topologyLinkDirection
+	7,  // 19: l8topo.L8TopologyLink.status:type_name -> l8topo.L8TopologyLinkStatus
+	19, // 20: l8topo.L8TopologyLink.bend_points:type_name -> l8topo.L8TopologyPoint
+	37, // 21: l8topo.L8TopologyLink.attributes:type_name -> l8topo.L8TopologyLink.AttributesEntry
+	18, // 22: l8topo.L8TopologyLink.aside_endpoint:type_name -> l8topo.L8TopologyLinkEndpoint
+	18, // 23: l8topo.L8TopologyLink.zside_endpoint:type_name -> l8topo.L8TopologyLinkEndpoint
+	8,  // 24: l8topo.L8TopologyLink.utilization_level:type_name -> l8topo.L8TopologyUtilizationLevel
+	18, // 25: l8topo.L8TopologyLink.member_aside_endpoints:type_name -> l8topo.L8TopologyLinkEndpoint
+	18, // 26: l8topo.L8TopologyLink.member_zside_endpoints:type_name -> l8topo.L8TopologyLinkEndpoint
+	0,  // 27: l8topo.L8TopologyPinnedPosition.layout:type_name -> l8topo.L8TopologyLayout
+	20, // 28: l8topo.L8TopologyPinnedPositionList.list:type_name -> l8topo.L8TopologyPinnedPosition
+	23, // 29: l8topo.L8TopologyMetadataList.list:type_name -> l8topo.L8TopologyMetadata
+	9,  // 30: l8topo.L8TopologyMetadata.status:type_name -> l8topo.L8TopologyStatus
+	25, // 31: l8topo.L8TopologyDiscoveryList.list:type_name -> l8topo.L8TopologyDiscovery
+	38, // 32: l8topo.L8TopologyDiscovery.node_types:type_name -> l8topo.L8TopologyDiscovery.NodeTypesEntry
+	39, // 33: l8topo.L8TopologyDiscovery.node_statuses:type_name -> l8topo.L8TopologyDiscovery.NodeStatusesEntry
+	26, // 34: l8topo.L8TopologyDiscovery.link_rule:type_name -> l8topo.L8TopologyLinkRule
+	40, // 35: l8topo.L8TopologyDiscovery.attribute_paths:type_name -> l8topo.L8TopologyDiscovery.AttributePathsEntry
+	25, // 36: l8topo.L8TopologyDiscovery.sources:type_name -> l8topo.L8TopologyDiscovery
+	28, // 37: l8topo.L8RoutingDeviceList.list:type_name -> l8topo.L8RoutingDevice
+	29, // 38: l8topo.L8RoutingDevice.adjacencies:type_name -> l8topo.L8RoutingAdjacency
+	10, // 39: l8topo.L8RoutingAdjacency.protocol:type_name -> l8topo.L8RoutingProtocol
+	15, // 40: l8topo.L8Topology.NodesEntry.value:type_name -> l8topo.L8TopologyNode
+	17, // 41: l8topo.L8Topology.LinksEntry.value:type_name -> l8topo.L8TopologyLink
+	16, // 42: l8topo.L8Topology.LocationsEntry.value:type_name -> l8topo.L8TopologyLocation
+	2,  // 43: l8topo.L8TopologyDiscovery.NodeTypesEntry.value:type_name -> l8topo.L8TopologyNodeType
+	3,  // 44: l8topo.L8TopologyDiscovery.NodeStatusesEntry.value:type_name -> l8topo.L8TopologyNodeStatus
+	45, // [45:45] is the sub-list for method output_type
+	45, // [45:45] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_topology_proto_init() }
@@ -921,7 +3698,7 @@ func file_topology_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_topology_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*L8TopologyQuery); i {
+			switch v := v.(*L8TopologyDetailLevel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -933,7 +3710,7 @@ func file_topology_proto_init() {
 			}
 		}
 		file_topology_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*L8Topology); i {
+			switch v := v.(*L8TopologyQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -945,7 +3722,7 @@ func file_topology_proto_init() {
 			}
 		}
 		file_topology_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*L8TopologyNode); i {
+			switch v := v.(*L8Topology); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -957,7 +3734,7 @@ func file_topology_proto_init() {
 			}
 		}
 		file_topology_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*L8TopologyLocation); i {
+			switch v := v.(*L8TopologyDiscoveryReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -969,7 +3746,7 @@ func file_topology_proto_init() {
 			}
 		}
 		file_topology_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*L8TopologyLink); i {
+			switch v := v.(*L8TopologyNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -981,7 +3758,7 @@ func file_topology_proto_init() {
 			}
 		}
 		file_topology_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*L8TopologyMetadataList); i {
+			switch v := v.(*L8TopologyLocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -993,6 +3770,78 @@ func file_topology_proto_init() {
 			}
 		}
 		file_topology_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8TopologyLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topology_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8TopologyLinkEndpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topology_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8TopologyPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topology_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8TopologyPinnedPosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topology_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8TopologyPinnedPositionList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topology_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8TopologyMetadataList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topology_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*L8TopologyMetadata); i {
 			case 0:
 				return &v.state
@@ -1004,14 +3853,86 @@ func file_topology_proto_init() {
 				return nil
 			}
 		}
+		file_topology_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8TopologyDiscoveryList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topology_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8TopologyDiscovery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topology_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8TopologyLinkRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topology_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8RoutingDeviceList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topology_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8RoutingDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topology_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*L8RoutingAdjacency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_topology_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   10,
+			NumEnums:      11,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   0,
 		},